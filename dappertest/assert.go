@@ -0,0 +1,152 @@
+package dappertest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dogmatiq/dapper"
+)
+
+// Option is an option that changes the behavior of an assertion.
+type Option func(*options)
+
+// WithPrinter sets the printer used to render values when an assertion fails.
+// By default, a printer with the default options is used.
+func WithPrinter(p *dapper.Printer) Option {
+	return func(o *options) {
+		o.Printer = p
+	}
+}
+
+// WithMaxDifferences sets the maximum number of differing paths to list when
+// an assertion fails. The default is 10. A value of zero means there is no
+// limit.
+func WithMaxDifferences(n int) Option {
+	return func(o *options) {
+		o.MaxDifferences = n
+	}
+}
+
+type options struct {
+	Printer        *dapper.Printer
+	MaxDifferences int
+}
+
+// Equal asserts that want and got are equal, as per the == operator.
+//
+// If they are not equal the test is marked as failed, and both values are
+// rendered along with the paths at which they differ. It returns true if the
+// values are equal.
+//
+// If T is an interface type and the values' dynamic type is not comparable,
+// such as a slice, the test is marked as failed instead of panicking.
+func Equal[T comparable](t testing.TB, want, got T, opts ...Option) bool {
+	t.Helper()
+
+	if !isComparable(want) || !isComparable(got) {
+		fail(t, "values are not comparable", want, got, Diff(want, got), opts)
+		return false
+	}
+
+	if want == got {
+		return true
+	}
+
+	fail(t, "values are not equal", want, got, Diff(want, got), opts)
+	return false
+}
+
+// DeepEqual asserts that want and got are "deeply equal", as per
+// [reflect.DeepEqual].
+//
+// If they are not deeply equal the test is marked as failed, and both values
+// are rendered along with the paths at which they differ. It returns true if
+// the values are deeply equal.
+func DeepEqual(t testing.TB, want, got any, opts ...Option) bool {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return true
+	}
+
+	fail(t, "values are not deeply equal", want, got, Diff(want, got), opts)
+	return false
+}
+
+// isComparable returns true if v can be compared using the == operator
+// without panicking.
+func isComparable[T comparable](v T) bool {
+	return reflect.ValueOf(&v).Elem().Comparable()
+}
+
+// fail marks the test as failed and reports the differences between want and
+// got.
+func fail(
+	t testing.TB,
+	message string,
+	want, got any,
+	diffs []Difference,
+	opts []Option,
+) {
+	t.Helper()
+
+	o := options{
+		Printer:        dapper.NewPrinter(),
+		MaxDifferences: 10,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// The values may be unequal without any differing content, such as two
+	// distinct pointers to equal values.
+	if len(diffs) == 0 {
+		diffs = []Difference{
+			{rootPath, reflect.ValueOf(want), reflect.ValueOf(got)},
+		}
+	}
+
+	var w strings.Builder
+
+	w.WriteString(message)
+	w.WriteString("\n\ndifferences:\n")
+
+	for i, d := range diffs {
+		if o.MaxDifferences > 0 && i == o.MaxDifferences {
+			fmt.Fprintf(&w, "    <%d more difference(s)>\n", len(diffs)-i)
+			break
+		}
+
+		fmt.Fprintf(
+			&w,
+			"    %s: want %s, got %s\n",
+			d.Path,
+			formatDiffValue(o.Printer, d.Want),
+			formatDiffValue(o.Printer, d.Got),
+		)
+	}
+
+	w.WriteString("\nwant:\n")
+	w.WriteString(indent(o.Printer.Format(want)))
+	w.WriteString("\n\ngot:\n")
+	w.WriteString(indent(o.Printer.Format(got)))
+
+	t.Error(w.String())
+}
+
+// formatDiffValue returns a single-line representation of v.
+func formatDiffValue(p *dapper.Printer, v reflect.Value) string {
+	if !v.IsValid() {
+		return missingMarker
+	}
+
+	return fmt.Sprintf("%v", p.Wrap(v.Interface()))
+}
+
+// indent indents each line of s.
+func indent(s string) string {
+	return "    " + strings.ReplaceAll(s, "\n", "\n    ")
+}
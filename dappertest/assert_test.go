@@ -0,0 +1,268 @@
+package dappertest_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/dogmatiq/dapper"
+	. "github.com/dogmatiq/dapper/dappertest"
+)
+
+type node struct {
+	Name     string
+	Labels   map[string]int
+	Children []*node
+}
+
+// fakeT is a [testing.TB] that records failures instead of failing the test.
+type fakeT struct {
+	testing.TB
+	failures []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Error(args ...any) {
+	t.failures = append(t.failures, fmt.Sprint(args...))
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("it passes when the values are equal", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		if !Equal(ft, 1, 1) {
+			t.Fatal("expected true")
+		}
+
+		if len(ft.failures) != 0 {
+			t.Fatalf("unexpected failure: %s", ft.failures[0])
+		}
+	})
+
+	t.Run("it fails when the values are not equal", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		if Equal(ft, "foo", "bar") {
+			t.Fatal("expected false")
+		}
+
+		expectFailure(
+			t,
+			ft,
+			`values are not equal`,
+			``,
+			`differences:`,
+			`    <root>: want "foo", got "bar"`,
+			``,
+			`want:`,
+			`    "foo"`,
+			``,
+			`got:`,
+			`    "bar"`,
+		)
+	})
+
+	t.Run("it fails when the values are not comparable", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		var want, got any = []int{1}, []int{2}
+
+		if Equal(ft, want, got) {
+			t.Fatal("expected false")
+		}
+
+		expectFailure(
+			t,
+			ft,
+			`values are not comparable`,
+			``,
+			`differences:`,
+			`    [0]: want int(1), got int(2)`,
+			``,
+			`want:`,
+			`    []int{`,
+			`        1`,
+			`    }`,
+			``,
+			`got:`,
+			`    []int{`,
+			`        2`,
+			`    }`,
+		)
+	})
+
+	t.Run("it reports distinct pointers to equal values", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		Equal(
+			ft,
+			&node{Name: "a"},
+			&node{Name: "a"},
+			WithPrinter(dapper.NewPrinter(dapper.WithPackagePaths(false))),
+		)
+
+		expectFailure(
+			t,
+			ft,
+			`values are not equal`,
+			``,
+			`differences:`,
+			`    <root>: want *dappertest_test.node{Name: "a", Labels: nil, Children: nil}, got *dappertest_test.node{Name: "a", Labels: nil, Children: nil}`,
+			``,
+			`want:`,
+			`    *dappertest_test.node{`,
+			`        Name:     "a"`,
+			`        Labels:   nil`,
+			`        Children: nil`,
+			`    }`,
+			``,
+			`got:`,
+			`    *dappertest_test.node{`,
+			`        Name:     "a"`,
+			`        Labels:   nil`,
+			`        Children: nil`,
+			`    }`,
+		)
+	})
+}
+
+func TestDeepEqual(t *testing.T) {
+	t.Run("it passes when the values are deeply equal", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		if !DeepEqual(ft, &node{Name: "a"}, &node{Name: "a"}) {
+			t.Fatal("expected true")
+		}
+
+		if len(ft.failures) != 0 {
+			t.Fatalf("unexpected failure: %s", ft.failures[0])
+		}
+	})
+
+	t.Run("it reports the root when there are no differing paths", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		// Map entries with NaN keys can not be looked up, so the maps are
+		// never deeply equal.
+		want := map[float64]int{math.NaN(): 1}
+		got := map[float64]int{math.NaN(): 1}
+
+		if DeepEqual(ft, want, got) {
+			t.Fatal("expected false")
+		}
+
+		expectFailure(
+			t,
+			ft,
+			`values are not deeply equal`,
+			``,
+			`differences:`,
+			`    <root>: want map[float64]int{NaN: 1}, got map[float64]int{NaN: 1}`,
+			``,
+			`want:`,
+			`    map[float64]int{`,
+			`        NaN: 1`,
+			`    }`,
+			``,
+			`got:`,
+			`    map[float64]int{`,
+			`        NaN: 1`,
+			`    }`,
+		)
+	})
+
+	t.Run("it highlights the differing paths", func(t *testing.T) {
+		ft := &fakeT{TB: t}
+
+		want := &node{
+			Name:   "root",
+			Labels: map[string]int{"a": 1, "b": 2},
+			Children: []*node{
+				{Name: "x"},
+			},
+		}
+
+		got := &node{
+			Name:   "root",
+			Labels: map[string]int{"a": 1, "c": 3},
+			Children: []*node{
+				{Name: "y"},
+				{Name: "z"},
+			},
+		}
+
+		if DeepEqual(
+			ft,
+			want,
+			got,
+			WithPrinter(dapper.NewPrinter(dapper.WithPackagePaths(false))),
+			WithMaxDifferences(3),
+		) {
+			t.Fatal("expected false")
+		}
+
+		expectFailure(
+			t,
+			ft,
+			`values are not deeply equal`,
+			``,
+			`differences:`,
+			`    .Labels["b"]: want int(2), got <missing>`,
+			`    .Labels["c"]: want <missing>, got int(3)`,
+			`    .Children[0].Name: want "x", got "y"`,
+			`    <1 more difference(s)>`,
+			``,
+			`want:`,
+			`    *dappertest_test.node{`,
+			`        Name:     "root"`,
+			`        Labels:   {`,
+			`            "a": 1`,
+			`            "b": 2`,
+			`        }`,
+			`        Children: {`,
+			`            {`,
+			`                Name:     "x"`,
+			`                Labels:   nil`,
+			`                Children: nil`,
+			`            }`,
+			`        }`,
+			`    }`,
+			``,
+			`got:`,
+			`    *dappertest_test.node{`,
+			`        Name:     "root"`,
+			`        Labels:   {`,
+			`            "a": 1`,
+			`            "c": 3`,
+			`        }`,
+			`        Children: {`,
+			`            {`,
+			`                Name:     "y"`,
+			`                Labels:   nil`,
+			`                Children: nil`,
+			`            }`,
+			`            {`,
+			`                Name:     "z"`,
+			`                Labels:   nil`,
+			`                Children: nil`,
+			`            }`,
+			`        }`,
+			`    }`,
+		)
+	})
+}
+
+func expectFailure(t *testing.T, ft *fakeT, lines ...string) {
+	t.Helper()
+
+	if len(ft.failures) != 1 {
+		t.Fatalf("expected exactly one failure, got %d", len(ft.failures))
+	}
+
+	expected := strings.Join(lines, "\n")
+	if ft.failures[0] != expected {
+		t.Fatalf("unexpected failure message:\n\nexpected:\n%s\n\nactual:\n%s", expected, ft.failures[0])
+	}
+}
@@ -0,0 +1,214 @@
+package dappertest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/dogmatiq/dapper"
+	"github.com/dogmatiq/dapper/internal/unsafereflect"
+)
+
+// missingMarker is the string to display in place of a value that is not
+// present, such as a map key that only exists in one of the values.
+const missingMarker = "<missing>"
+
+// rootPath is the path that refers to the values being compared themselves.
+const rootPath = "<root>"
+
+// Difference describes a path at which two values differ.
+type Difference struct {
+	// Path is the Go expression used to reach the differing values, relative
+	// to the values being compared, such as ".Children[1].Name".
+	Path string
+
+	// Want and Got are the differing values. A value is invalid if it is not
+	// present at the path, such as when a slice is shorter than the other.
+	Want, Got reflect.Value
+}
+
+// Diff returns the paths at which want and got differ, as per
+// [reflect.DeepEqual].
+func Diff(want, got any) []Difference {
+	d := differ{
+		visited: map[visit]struct{}{},
+	}
+
+	d.diff("", reflect.ValueOf(want), reflect.ValueOf(got))
+
+	return d.diffs
+}
+
+// visit is a pair of pointers that have already been compared, used to avoid
+// infinite recursion when comparing cyclic values.
+type visit struct {
+	want, got uintptr
+	typ       reflect.Type
+}
+
+type differ struct {
+	visited map[visit]struct{}
+	diffs   []Difference
+}
+
+func (d *differ) report(path string, want, got reflect.Value) {
+	if path == "" {
+		path = rootPath
+	}
+
+	if want.IsValid() {
+		want = unsafereflect.MakeMutable(want)
+	}
+
+	if got.IsValid() {
+		got = unsafereflect.MakeMutable(got)
+	}
+
+	d.diffs = append(d.diffs, Difference{path, want, got})
+}
+
+func (d *differ) diff(path string, want, got reflect.Value) {
+	if !want.IsValid() || !got.IsValid() {
+		if want.IsValid() != got.IsValid() {
+			d.report(path, want, got)
+		}
+		return
+	}
+
+	if want.Type() != got.Type() {
+		d.report(path, want, got)
+		return
+	}
+
+	switch want.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		if want.Pointer() == got.Pointer() &&
+			(want.Kind() != reflect.Slice || want.Len() == got.Len()) {
+			return
+		}
+
+		if !want.IsNil() && !got.IsNil() {
+			v := visit{want.Pointer(), got.Pointer(), want.Type()}
+			if _, ok := d.visited[v]; ok {
+				return
+			}
+			d.visited[v] = struct{}{}
+		}
+	}
+
+	switch want.Kind() {
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			d.diff(
+				path+"."+want.Type().Field(i).Name,
+				want.Field(i),
+				got.Field(i),
+			)
+		}
+
+	case reflect.Array:
+		d.diffElements(path, want, got)
+
+	case reflect.Slice:
+		if want.IsNil() != got.IsNil() {
+			d.report(path, want, got)
+		} else {
+			d.diffElements(path, want, got)
+		}
+
+	case reflect.Map:
+		if want.IsNil() != got.IsNil() {
+			d.report(path, want, got)
+		} else {
+			d.diffMaps(path, want, got)
+		}
+
+	case reflect.Ptr, reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() != got.IsNil() {
+				d.report(path, want, got)
+			}
+		} else if want.Kind() == reflect.Interface &&
+			want.Elem().Type() != got.Elem().Type() {
+			d.report(path, want, got)
+		} else {
+			d.diff(path, want.Elem(), got.Elem())
+		}
+
+	default:
+		if !reflect.DeepEqual(
+			unsafereflect.MakeMutable(want).Interface(),
+			unsafereflect.MakeMutable(got).Interface(),
+		) {
+			d.report(path, want, got)
+		}
+	}
+}
+
+// diffElements compares the elements of two arrays or slices.
+func (d *differ) diffElements(path string, want, got reflect.Value) {
+	n := max(want.Len(), got.Len())
+
+	for i := 0; i < n; i++ {
+		var w, g reflect.Value
+
+		if i < want.Len() {
+			w = want.Index(i)
+		}
+
+		if i < got.Len() {
+			g = got.Index(i)
+		}
+
+		d.diff(fmt.Sprintf("%s[%d]", path, i), w, g)
+	}
+}
+
+// diffMaps compares the entries of two maps.
+func (d *differ) diffMaps(path string, want, got reflect.Value) {
+	type entry struct {
+		path string
+		key  reflect.Value
+	}
+
+	var entries []entry
+	seen := map[string]struct{}{}
+
+	for _, m := range []reflect.Value{want, got} {
+		for _, k := range m.MapKeys() {
+			kp := fmt.Sprintf("%s[%s]", path, formatMapKey(k))
+
+			if _, ok := seen[kp]; !ok {
+				seen[kp] = struct{}{}
+				entries = append(entries, entry{kp, k})
+			}
+		}
+	}
+
+	sort.Slice(
+		entries,
+		func(i, j int) bool {
+			return entries[i].path < entries[j].path
+		},
+	)
+
+	for _, e := range entries {
+		d.diff(e.path, want.MapIndex(e.key), got.MapIndex(e.key))
+	}
+}
+
+// formatMapKey returns the representation of a map key within a path.
+func formatMapKey(k reflect.Value) string {
+	k = unsafereflect.MakeMutable(k)
+
+	switch k.Kind() {
+	case reflect.String,
+		reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%#v", k.Interface())
+	}
+
+	return dapper.NewPrinter(dapper.WithCompact(true)).Format(k.Interface())
+}
@@ -0,0 +1,58 @@
+package dappertest_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/dogmatiq/dapper/dappertest"
+)
+
+func TestDiff(t *testing.T) {
+	type inner struct {
+		value int
+	}
+
+	type outer struct {
+		Any   any
+		Inner inner
+		Ints  [2]int
+		Func  func()
+	}
+
+	cyclic := func(name string) *node {
+		n := &node{Name: name}
+		n.Children = []*node{n}
+		return n
+	}
+
+	cases := []struct {
+		Name      string
+		Want, Got any
+		Paths     []string
+	}{
+		{"equal values", outer{Any: 1}, outer{Any: 1}, nil},
+		{"nil values", nil, nil, nil},
+		{"nil and non-nil values", nil, 1, []string{"<root>"}},
+		{"different types", 1, "1", []string{"<root>"}},
+		{"interface with different dynamic types", outer{Any: 1}, outer{Any: "1"}, []string{".Any"}},
+		{"unexported fields", outer{Inner: inner{1}}, outer{Inner: inner{2}}, []string{".Inner.value"}},
+		{"array elements", outer{Ints: [2]int{1, 2}}, outer{Ints: [2]int{1, 3}}, []string{".Ints[1]"}},
+		{"non-nil functions", outer{Func: func() {}}, outer{Func: func() {}}, []string{".Func"}},
+		{"nil and empty slices", []int(nil), []int{}, []string{"<root>"}},
+		{"map with integer keys", map[int]string{1: "a"}, map[int]string{1: "b"}, []string{"[1]"}},
+		{"cyclic values", cyclic("a"), cyclic("b"), []string{".Name"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			var paths []string
+			for _, d := range Diff(c.Want, c.Got) {
+				paths = append(paths, d.Path)
+			}
+
+			if !reflect.DeepEqual(paths, c.Paths) {
+				t.Fatalf("unexpected paths: got %q, want %q", paths, c.Paths)
+			}
+		})
+	}
+}
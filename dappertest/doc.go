@@ -0,0 +1,3 @@
+// Package dappertest provides test assertions that describe failures using
+// Dapper's pretty-printed representation of the values involved.
+package dappertest
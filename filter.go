@@ -5,13 +5,26 @@ package dapper
 //
 // The filter uses r to render v. If r is unused v is rendered using the default
 // formatting logic.
+//
+// If the filter panics, any output it has produced is discarded and v is
+// rendered as though the filter had not been applied, followed by a marker
+// describing the panic.
 type Filter func(r Renderer, v Value)
 
 var defaultFilters = []Filter{
 	StringerFilter, // always first
+	AtomicFilter,
+	BigFilter,
+	ContextFilter,
 	ErrorFilter,
+	HTTPFilter,
+	JSONFilter,
+	NetFilter,
 	ProtoFilter,
 	ReflectFilter,
+	SlogFilter,
+	SQLFilter,
+	StackTraceFilter,
 	SyncFilter,
 	TimeFilter,
 }
@@ -0,0 +1,64 @@
+package dapper
+
+import (
+	"reflect"
+
+	"github.com/dogmatiq/dapper/internal/unsafereflect"
+)
+
+// AtomicFilter is a [Filter] that formats the types in the [sync/atomic]
+// package as the value they contain.
+func AtomicFilter(r Renderer, v Value) {
+	loaded, ok := loadAtomic(v.Value)
+	if !ok {
+		return
+	}
+
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+		r.Print("(")
+		defer r.Print(")")
+	}
+
+	r.WriteValue(
+		Value{
+			Value:                  loaded,
+			DynamicType:            loaded.Type(),
+			StaticType:             loaded.Type(),
+			IsAmbiguousDynamicType: false,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           v.IsUnexported,
+		},
+	)
+}
+
+// loadAtomic returns the value contained within v, if v is one of the types
+// in the [sync/atomic] package.
+func loadAtomic(v reflect.Value) (reflect.Value, bool) {
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	t := v.Type()
+
+	if t.Kind() != reflect.Struct || t.PkgPath() != "sync/atomic" {
+		return reflect.Value{}, false
+	}
+
+	m, ok := reflect.PointerTo(t).MethodByName("Load")
+	if !ok || m.Type.NumIn() != 1 || m.Type.NumOut() != 1 {
+		return reflect.Value{}, false
+	}
+
+	v = unsafereflect.MakeMutable(v)
+
+	// The Load() method requires a pointer receiver. If v is not addressable
+	// we load the value from a copy instead.
+	if !v.CanAddr() {
+		c := reflect.New(t).Elem()
+		c.Set(v)
+		v = c
+	}
+
+	return m.Func.Call([]reflect.Value{v.Addr()})[0], true
+}
@@ -0,0 +1,107 @@
+package dapper_test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+type atomicPoint struct {
+	X, Y int
+}
+
+func TestPrinter_AtomicFilter(t *testing.T) {
+	var (
+		b   atomic.Bool
+		i32 atomic.Int32
+		i64 atomic.Int64
+		u32 atomic.Uint32
+		u64 atomic.Uint64
+		ptr atomic.Uintptr
+		val atomic.Value
+	)
+
+	b.Store(true)
+	i32.Store(-32)
+	i64.Store(-64)
+	u32.Store(32)
+	u64.Store(64)
+	ptr.Store(0xabcd)
+
+	test(t, "atomic.Bool", &b, "*sync/atomic.Bool(true)")
+	test(t, "atomic.Int32", &i32, "*sync/atomic.Int32(-32)")
+	test(t, "atomic.Int64", &i64, "*sync/atomic.Int64(-64)")
+	test(t, "atomic.Uint32", &u32, "*sync/atomic.Uint32(32)")
+	test(t, "atomic.Uint64", &u64, "*sync/atomic.Uint64(64)")
+	test(t, "atomic.Uintptr", &ptr, "*sync/atomic.Uintptr(0xabcd)")
+	test(t, "atomic.Value (empty)", &val, "*sync/atomic.Value(nil)")
+
+	val.Store(123)
+	test(t, "atomic.Value", &val, "*sync/atomic.Value(int(123))")
+
+	var p atomic.Pointer[atomicPoint]
+	test(t, "atomic.Pointer (nil)", &p, "*sync/atomic.Pointer[github.com/dogmatiq/dapper_test.atomicPoint](nil)")
+
+	p.Store(&atomicPoint{1, 2})
+	test(
+		t,
+		"atomic.Pointer",
+		&p,
+		"*sync/atomic.Pointer[github.com/dogmatiq/dapper_test.atomicPoint]({",
+		"    X: 1",
+		"    Y: 2",
+		"})",
+	)
+
+	type named struct {
+		Count   atomic.Int64
+		enabled atomic.Bool
+		Value   atomic.Value
+	}
+
+	n := &named{}
+	n.Count.Store(42)
+	n.enabled.Store(true)
+	n.Value.Store("foo")
+
+	test(
+		t,
+		"excludes type information if it is not ambiguous",
+		n,
+		"*github.com/dogmatiq/dapper_test.named{",
+		"    Count:   42",
+		"    enabled: true",
+		`    Value:   "foo"`,
+		"}",
+	)
+}
+
+func TestPrinter_AtomicFilter_maxDepth(t *testing.T) {
+	type inner struct {
+		X atomicPoint
+	}
+
+	type outer struct {
+		A atomic.Pointer[inner]
+		B *inner
+	}
+
+	v := &outer{B: &inner{X: atomicPoint{1, 2}}}
+	v.A.Store(&inner{X: atomicPoint{1, 2}})
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxDepth(2)),
+		"the atomic value does not count towards the depth",
+		v,
+		"*github.com/dogmatiq/dapper_test.outer{",
+		"    A: {",
+		"        X: {...}",
+		"    }",
+		"    B: {",
+		"        X: {...}",
+		"    }",
+		"}",
+	)
+}
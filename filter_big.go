@@ -0,0 +1,24 @@
+package dapper
+
+import (
+	"math/big"
+)
+
+// BigFilter is a [Filter] that formats the number types from the [math/big]
+// package.
+func BigFilter(r Renderer, v Value) {
+	if i, ok := AsConcrete[big.Int](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", i.String())
+	} else if q, ok := AsConcrete[big.Rat](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", q.String())
+	} else if f, ok := AsConcrete[big.Float](v); ok {
+		printWithTypeIfAmbiguous(
+			r,
+			v,
+			"%s <prec: %d, mode: %s>",
+			f.Text('g', -1),
+			f.Prec(),
+			f.Mode(),
+		)
+	}
+}
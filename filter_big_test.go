@@ -0,0 +1,45 @@
+package dapper_test
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPrinter_BigFilter(t *testing.T) {
+	i, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	test(t, "*big.Int", i, "*math/big.Int(123456789012345678901234567890)")
+	test(t, "*big.Int (negative)", big.NewInt(-5), "*math/big.Int(-5)")
+	test(t, "*big.Int (nil)", (*big.Int)(nil), "*math/big.Int(nil)")
+	test(t, "big.Int (zero)", big.Int{}, "math/big.Int(0)")
+
+	test(t, "*big.Rat", big.NewRat(3, 7), "*math/big.Rat(3/7)")
+	test(t, "*big.Rat (integer)", big.NewRat(6, 3), "*math/big.Rat(2/1)")
+
+	test(t, "*big.Float", big.NewFloat(1.5), "*math/big.Float(1.5 <prec: 53, mode: ToNearestEven>)")
+
+	f := new(big.Float).SetPrec(200).SetMode(big.ToZero)
+	f.SetString("3.14159265358979323846264338327950288419716939937510582097494459")
+	test(t, "*big.Float (high precision)", f, "*math/big.Float(3.141592653589793238462643383279502884197169399375105820974944 <prec: 200, mode: ToZero>)")
+
+	type named struct {
+		Int   *big.Int
+		Rat   big.Rat
+		float *big.Float
+	}
+
+	test(
+		t,
+		"excludes type information if it is not ambiguous",
+		named{
+			Int:   big.NewInt(100),
+			Rat:   *big.NewRat(1, 2),
+			float: big.NewFloat(0.25),
+		},
+		"github.com/dogmatiq/dapper_test.named{",
+		"    Int:   100",
+		"    Rat:   1/2",
+		"    float: 0.25 <prec: 53, mode: ToNearestEven>",
+		"}",
+	)
+}
@@ -0,0 +1,172 @@
+package dapper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/dogmatiq/dapper/internal/unsafereflect"
+)
+
+// ContextFilter is a [Filter] that formats implementations of
+// [context.Context] from the [context] package.
+//
+// Each layer of the context's parent chain is rendered on its own line, from
+// the innermost (most recently derived) context to the root.
+func ContextFilter(r Renderer, v Value) {
+	if !isContextLayer(v.DynamicType) {
+		return
+	}
+
+	ctx, ok := AsImplementationOf[context.Context](v)
+	if !ok {
+		return
+	}
+
+	// Render the type if the static type is ambiguous or something other than
+	// [context.Context] (i.e, some user defined interface).
+	if v.IsAmbiguousStaticType || v.StaticType != typeOf[context.Context]() {
+		// Always render the type as [context.Context] (the interface), rather
+		// than whatever internal type actually implements it, as that is
+		// generally meaningless to the user.
+		r.Print("context.Context")
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for ctx != nil {
+		ctx = renderContextLayer(r, v, ctx)
+	}
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// isContextLayer returns true if t is one of the context implementations
+// within the [context] package.
+func isContextLayer(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.PkgPath() == "context" && t.Kind() == reflect.Struct
+}
+
+// renderContextLayer renders a single layer of a context's parent chain, and
+// returns the parent context.
+//
+// It returns nil if ctx is a root context, or if its parent can not be
+// determined.
+func renderContextLayer(
+	r Renderer,
+	v Value,
+	ctx context.Context,
+) context.Context {
+	rv := reflect.ValueOf(ctx)
+	rt := rv.Type()
+
+	if !isContextLayer(rt) {
+		r.Print("%s\n", r.FormatType(Value{Value: rv, DynamicType: rt}))
+		return nil
+	}
+
+	if rt.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+		rt = rt.Elem()
+	}
+
+	parentField := "Context"
+
+	switch rt.Name() {
+	case "backgroundCtx":
+		r.Print("Background\n")
+		return nil
+	case "todoCtx":
+		r.Print("TODO\n")
+		return nil
+	case "cancelCtx":
+		r.Print("WithCancel(%s)\n", formatContextState(ctx))
+	case "afterFuncCtx":
+		r.Print("AfterFunc(%s)\n", formatContextState(ctx))
+	case "timerCtx":
+		d, _ := ctx.Deadline()
+
+		state := formatContextState(ctx)
+		if ctx.Err() == nil {
+			state = fmt.Sprintf(
+				"<remaining: %s>",
+				time.Until(d).Round(time.Millisecond),
+			)
+		}
+
+		r.Print(
+			"WithDeadline(%s, %s)\n",
+			r.FormatValue(
+				Value{
+					Value:       reflect.ValueOf(d),
+					DynamicType: typeOf[time.Time](),
+					StaticType:  typeOf[time.Time](),
+				},
+			),
+			state,
+		)
+	case "valueCtx":
+		r.Print(
+			"WithValue(%s: %s)\n",
+			formatContextField(r, v, rv, "key"),
+			formatContextField(r, v, rv, "val"),
+		)
+	case "withoutCancelCtx":
+		r.Print("WithoutCancel\n")
+		parentField = "c"
+	case "stopCtx":
+		// stopCtx is an implementation detail used to wrap the parent of a
+		// context derived from a context with an AfterFunc registration.
+	default:
+		r.Print("%s\n", r.FormatType(Value{Value: rv, DynamicType: rt}))
+	}
+
+	f := rv.FieldByName(parentField)
+	if !f.IsValid() || f.Kind() != reflect.Interface || f.IsNil() {
+		return nil
+	}
+
+	parent, _ := unsafereflect.MakeMutable(f).Interface().(context.Context)
+	return parent
+}
+
+// formatContextState returns a description of the cancellation state of ctx.
+func formatContextState(ctx context.Context) string {
+	err := ctx.Err()
+	if err == nil {
+		return "<active>"
+	}
+
+	if cause := context.Cause(ctx); cause != nil && cause != err {
+		return fmt.Sprintf("<canceled: %s, cause: %s>", err, cause)
+	}
+
+	return fmt.Sprintf("<canceled: %s>", err)
+}
+
+// formatContextField returns the rendered value of the field with the given
+// name within a context layer.
+func formatContextField(r Renderer, v Value, layer reflect.Value, name string) string {
+	f := layer.FieldByName(name)
+	if !f.IsValid() {
+		return "<unknown>"
+	}
+
+	return r.FormatValue(
+		Value{
+			Value:                  f,
+			DynamicType:            f.Type(),
+			StaticType:             f.Type(),
+			IsAmbiguousDynamicType: true,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           v.IsUnexported,
+		},
+	)
+}
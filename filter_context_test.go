@@ -0,0 +1,103 @@
+package dapper_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+type contextKey string
+
+func TestPrinter_ContextFilter(t *testing.T) {
+	test(
+		t,
+		"background",
+		context.Background(),
+		"context.Context{",
+		"    Background",
+		"}",
+	)
+
+	test(
+		t,
+		"todo",
+		context.TODO(),
+		"context.Context{",
+		"    TODO",
+		"}",
+	)
+
+	ctx := context.WithValue(context.Background(), contextKey("<key>"), 123)
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	test(
+		t,
+		"values and cancellation",
+		context.WithValue(ctx, contextKey("<other>"), "<value>"),
+		"context.Context{",
+		`    WithValue(github.com/dogmatiq/dapper_test.contextKey("<other>"): "<value>")`,
+		"    WithCancel(<active>)",
+		`    WithValue(github.com/dogmatiq/dapper_test.contextKey("<key>"): int(123))`,
+		"    Background",
+		"}",
+	)
+
+	cancel(errors.New("<cause>"))
+
+	test(
+		t,
+		"canceled",
+		ctx,
+		"context.Context{",
+		"    WithCancel(<canceled: context canceled, cause: <cause>>)",
+		`    WithValue(github.com/dogmatiq/dapper_test.contextKey("<key>"): int(123))`,
+		"    Background",
+		"}",
+	)
+
+	deadline := time.Date(2019, time.November, 3, 10, 13, 8, 839511000, time.UTC)
+	expired, cancelExpired := context.WithDeadline(context.WithoutCancel(context.TODO()), deadline)
+	defer cancelExpired()
+
+	test(
+		t,
+		"expired deadline",
+		expired,
+		"context.Context{",
+		"    WithDeadline(2019-11-03T10:13:08.839511Z, <canceled: context deadline exceeded>)",
+		"    WithoutCancel",
+		"    TODO",
+		"}",
+	)
+
+	type named struct {
+		Ctx context.Context
+	}
+
+	test(
+		t,
+		"excludes type information if it is not ambiguous",
+		named{context.Background()},
+		"github.com/dogmatiq/dapper_test.named{",
+		"    Ctx: {",
+		"        Background",
+		"    }",
+		"}",
+	)
+}
+
+func TestPrinter_ContextFilter_remaining(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	d, _ := ctx.Deadline()
+	s := Format(ctx)
+
+	if !strings.Contains(s, "WithDeadline("+d.Format(time.RFC3339Nano)+", <remaining: ") {
+		t.Fatalf("unexpected output:\n%s", s)
+	}
+}
@@ -0,0 +1,142 @@
+package dapper
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// unknownEnumMarker is the string to display after a value of an enumerated
+// type that does not have a registered name.
+const unknownEnumMarker = "<unknown>"
+
+// WithEnum adds a [Filter] that renders values of the enumerated type T using
+// the names in the given map.
+//
+// Values that do not appear in the map are rendered using the default
+// formatting logic for T, followed by an "<unknown>" marker.
+func WithEnum[T integer | ~string](names map[T]string) Option {
+	names = maps.Clone(names)
+
+	return WithFilter(
+		func(r Renderer, v Value) {
+			x, ok := AsConcrete[T](v)
+			if !ok {
+				return
+			}
+
+			if n, ok := names[x]; ok {
+				printWithTypeIfAmbiguous(r, v, "%s", n)
+			} else {
+				renderUnknownEnum(r, v)
+			}
+		},
+	)
+}
+
+// WithBitFlags adds a [Filter] that renders values of the bit-flag type T as a
+// combination of the names in the given map, such as "Read|Write".
+//
+// Any bits that do not correspond to a named value are rendered as a single
+// integer at the end of the combination, followed by an "<unknown>" marker.
+func WithBitFlags[T integer](names map[T]string) Option {
+	type flag struct {
+		Bits uint64
+		Name string
+	}
+
+	var (
+		exact = maps.Clone(names)
+		flags []flag
+	)
+
+	for x, n := range names {
+		if bits := integerBits(reflect.ValueOf(x)); bits != 0 {
+			flags = append(flags, flag{bits, n})
+		}
+	}
+
+	slices.SortFunc(
+		flags,
+		func(a, b flag) int {
+			if a.Bits < b.Bits {
+				return -1
+			} else if a.Bits > b.Bits {
+				return 1
+			}
+			return strings.Compare(a.Name, b.Name)
+		},
+	)
+
+	return WithFilter(
+		func(r Renderer, v Value) {
+			x, ok := AsConcrete[T](v)
+			if !ok {
+				return
+			}
+
+			if n, ok := exact[x]; ok {
+				printWithTypeIfAmbiguous(r, v, "%s", n)
+				return
+			}
+
+			bits := integerBits(v.Value)
+			if bits == 0 {
+				renderUnknownEnum(r, v)
+				return
+			}
+
+			var parts []string
+			for _, f := range flags {
+				if bits&f.Bits == f.Bits {
+					parts = append(parts, f.Name)
+					bits &^= f.Bits
+				}
+			}
+
+			if bits != 0 {
+				f, _ := integerFormat(configOf(r), v.DynamicType)
+				parts = append(parts, formatUint(bits, f)+" "+unknownEnumMarker)
+			}
+
+			printWithTypeIfAmbiguous(r, v, "%s", strings.Join(parts, "|"))
+		},
+	)
+}
+
+// renderUnknownEnum renders a value of an enumerated type that does not have a
+// registered name.
+func renderUnknownEnum(r Renderer, v Value) {
+	var s string
+
+	switch v.DynamicType.Kind() {
+	case reflect.String:
+		s = fmt.Sprintf("%#v", v.Value.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, _ := integerFormat(configOf(r), v.DynamicType)
+		s = formatInt(v.Value.Int(), f)
+	default:
+		f, _ := integerFormat(configOf(r), v.DynamicType)
+		s = formatUint(v.Value.Uint(), f)
+	}
+
+	printWithTypeIfAmbiguous(r, v, "%s %s", s, unknownEnumMarker)
+}
+
+// integerBits returns the bits of an integer value as a uint64.
+func integerBits(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Mask off the sign extension so that negative values only occupy the
+		// bits of their own size.
+		bits := uint64(v.Int())
+		if size := v.Type().Bits(); size < 64 {
+			bits &= 1<<size - 1
+		}
+		return bits
+	default:
+		return v.Uint()
+	}
+}
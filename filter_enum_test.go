@@ -0,0 +1,96 @@
+package dapper_test
+
+import (
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+type status int
+
+const (
+	statusPending status = iota
+	statusActive
+	statusClosed
+)
+
+type color string
+
+type permission uint8
+
+const (
+	permissionRead permission = 1 << iota
+	permissionWrite
+	permissionExecute
+)
+
+func TestPrinter_WithEnum(t *testing.T) {
+	p := NewPrinter(
+		WithEnum(map[status]string{
+			statusPending: "Pending",
+			statusActive:  "Active",
+			statusClosed:  "Closed",
+		}),
+		WithEnum(map[color]string{
+			"r": "Red",
+		}),
+	)
+
+	testWithPrinter(t, p, "integer enum", statusActive, "github.com/dogmatiq/dapper_test.status(Active)")
+	testWithPrinter(t, p, "unknown integer enum", status(10), "github.com/dogmatiq/dapper_test.status(10 <unknown>)")
+	testWithPrinter(t, p, "string enum", color("r"), "github.com/dogmatiq/dapper_test.color(Red)")
+	testWithPrinter(t, p, "unknown string enum", color("x"), `github.com/dogmatiq/dapper_test.color("x" <unknown>)`)
+
+	type named struct {
+		Status status
+		Other  int
+	}
+
+	testWithPrinter(
+		t,
+		p,
+		"excludes type information if it is not ambiguous",
+		named{Status: statusClosed, Other: 1},
+		"github.com/dogmatiq/dapper_test.named{",
+		"    Status: Closed",
+		"    Other:  1",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(
+			WithEnum(map[status]string{}),
+			WithIntegerFormat[status](IntegerFormat{Base: 16}),
+		),
+		"unknown values use the integer format",
+		status(255),
+		"github.com/dogmatiq/dapper_test.status(0xff <unknown>)",
+	)
+}
+
+func TestPrinter_WithBitFlags(t *testing.T) {
+	p := NewPrinter(
+		WithBitFlags(map[permission]string{
+			0:                 "None",
+			permissionRead:    "Read",
+			permissionWrite:   "Write",
+			permissionExecute: "Execute",
+			permissionRead | permissionWrite | permissionExecute: "All",
+		}),
+	)
+
+	testWithPrinter(t, p, "zero value", permission(0), "github.com/dogmatiq/dapper_test.permission(None)")
+	testWithPrinter(t, p, "single flag", permissionWrite, "github.com/dogmatiq/dapper_test.permission(Write)")
+	testWithPrinter(t, p, "multiple flags", permissionRead|permissionExecute, "github.com/dogmatiq/dapper_test.permission(Read|Execute)")
+	testWithPrinter(t, p, "exact match", permission(7), "github.com/dogmatiq/dapper_test.permission(All)")
+	testWithPrinter(t, p, "unknown bits", permissionRead|0x30, "github.com/dogmatiq/dapper_test.permission(Read|48 <unknown>)")
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithBitFlags(map[permission]string{permissionRead: "Read"})),
+		"zero value without a name",
+		permission(0),
+		"github.com/dogmatiq/dapper_test.permission(0 <unknown>)",
+	)
+}
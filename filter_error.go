@@ -1,9 +1,132 @@
 package dapper
 
+import (
+	"reflect"
+	"strings"
+)
+
 // ErrorFilter is a [Filter] that formats implementations of [error].
+//
+// If the error has a StackTrace() method, the stack trace is rendered beneath
+// the error.
 func ErrorFilter(r Renderer, v Value) {
 	if e, ok := AsImplementationOf[error](v); ok {
+		if configOf(r).RenderErrorTrees {
+			renderErrorTree(r, e, map[uintptr]struct{}{})
+			return
+		}
+
 		r.WriteValue(v)
 		r.Print(" [%s]", e.Error())
+
+		if frames, ok := stackTraceOf(e); ok {
+			r.Indent()
+			for _, line := range formatFrames(r, "at ", frames) {
+				r.Print("\n%s", line)
+			}
+			r.Outdent()
+		}
+	}
+}
+
+// renderErrorTree renders e and the errors it wraps as a tree, with each
+// layer showing its concrete type and message.
+//
+// seen is the set of pointer-based errors that are currently being rendered,
+// used to detect cycles in the chain.
+func renderErrorTree(r Renderer, e error, seen map[uintptr]struct{}) {
+	rv := reflect.ValueOf(e)
+
+	r.WriteType(Value{Value: rv, DynamicType: rv.Type()})
+
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		ptr := rv.Pointer()
+		if _, ok := seen[ptr]; ok {
+			r.Print("(%s)", recursionMarker)
+			return
+		}
+
+		seen[ptr] = struct{}{}
+		defer delete(seen, ptr)
+	}
+
+	children := unwrapError(e)
+
+	if m := errorMessage(e, children); m != "" {
+		r.Print(" [%s]", m)
+	}
+
+	frames, _ := stackTraceOf(e)
+
+	if len(children) == 0 && len(frames) == 0 {
+		return
+	}
+
+	r.Print(" {\n")
+	r.Indent()
+
+	for _, line := range formatFrames(r, "at ", frames) {
+		r.Print("%s\n", line)
+	}
+
+	for _, c := range children {
+		renderErrorTree(r, c, seen)
+		r.Print("\n")
 	}
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// unwrapError returns the errors wrapped by e, if any.
+func unwrapError(e error) []error {
+	var errs []error
+
+	switch e := e.(type) {
+	case interface{ Unwrap() error }:
+		errs = []error{e.Unwrap()}
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	}
+
+	// Remove any nil errors, which are ignored by [errors.Is] and [errors.As].
+	// Note that the slice returned by Unwrap() must not be modified.
+	var result []error
+	for _, c := range errs {
+		if c != nil {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// errorMessage returns the message of e, with any text that repeats the
+// messages of the wrapped errors removed.
+func errorMessage(e error, children []error) string {
+	m := e.Error()
+
+	switch len(children) {
+	case 0:
+		return m
+	case 1:
+		// Strip the wrapped message from the end of the message, as produced
+		// by fmt.Errorf("<context>: %w", err).
+		if s, ok := strings.CutSuffix(m, children[0].Error()); ok {
+			return strings.TrimRight(s, ": \t\n")
+		}
+	default:
+		// Omit the message entirely if it is nothing more than a list of the
+		// wrapped messages, as produced by [errors.Join].
+		var messages []string
+		for _, c := range children {
+			messages = append(messages, c.Error())
+		}
+
+		if m == strings.Join(messages, "\n") {
+			return ""
+		}
+	}
+
+	return m
 }
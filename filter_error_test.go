@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+
+	. "github.com/dogmatiq/dapper"
 )
 
 type errorType struct {
@@ -65,3 +67,95 @@ func TestPrinter_ErrorFilter(t *testing.T) {
 		"}",
 	)
 }
+
+type selfWrappingError struct{}
+
+func (e *selfWrappingError) Error() string { return "<self>" }
+func (e *selfWrappingError) Unwrap() error { return e }
+
+func TestPrinter_ErrorFilter_WithErrorTrees(t *testing.T) {
+	p := NewPrinter(WithErrorTrees(true))
+
+	testWithPrinter(
+		t,
+		p,
+		"unwrapped error",
+		errors.New("<error>"),
+		"*errors.errorString [<error>]",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"wrapped error",
+		fmt.Errorf(
+			"<outer>: %w",
+			fmt.Errorf(
+				"<middle>: %w",
+				errorType{"<inner>"},
+			),
+		),
+		"*fmt.wrapError [<outer>] {",
+		"    *fmt.wrapError [<middle>] {",
+		"        github.com/dogmatiq/dapper_test.errorType [error: <inner>]",
+		"    }",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"wrapped message that does not repeat the inner message",
+		fmt.Errorf("<outer> (%w)", errors.New("<inner>")),
+		"*fmt.wrapError [<outer> (<inner>)] {",
+		"    *errors.errorString [<inner>]",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"joined errors",
+		errors.Join(
+			errors.New("<first>"),
+			fmt.Errorf("<second>: %w", errors.New("<third>")),
+		),
+		"*errors.joinError {",
+		"    *errors.errorString [<first>]",
+		"    *fmt.wrapError [<second>] {",
+		"        *errors.errorString [<third>]",
+		"    }",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"multiple %w verbs",
+		fmt.Errorf("<outer>: %w, %w", errors.New("<a>"), errors.New("<b>")),
+		"*fmt.wrapErrors [<outer>: <a>, <b>] {",
+		"    *errors.errorString [<a>]",
+		"    *errors.errorString [<b>]",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"cyclic error chain",
+		&selfWrappingError{},
+		"*github.com/dogmatiq/dapper_test.selfWrappingError {",
+		"    *github.com/dogmatiq/dapper_test.selfWrappingError(<recursion>)",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"error within a struct",
+		struct{ Err error }{errors.New("<error>")},
+		"{",
+		"    Err: *errors.errorString [<error>]",
+		"}",
+	)
+}
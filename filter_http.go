@@ -0,0 +1,204 @@
+package dapper
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/jumble/natsort"
+)
+
+// redactedMarker is the string to display in place of sensitive data.
+const redactedMarker = "<redacted>"
+
+// sensitiveHeaders is the set of HTTP headers that are redacted unless
+// [Config.RenderSensitiveData] is true.
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Cookie":              {},
+	"Proxy-Authorization": {},
+	"Set-Cookie":          {},
+}
+
+// HTTPFilter is a [Filter] that formats requests, responses and headers from
+// the [net/http] package, as well as [url.Values].
+//
+// Requests and responses are rendered compactly, showing only the most
+// commonly relevant fields. Bodies are never read.
+//
+// Sensitive headers, such as Authorization and Cookie, are redacted unless
+// [Config.RenderSensitiveData] is true.
+func HTTPFilter(r Renderer, v Value) {
+	if req, ok := AsConcrete[http.Request](v); ok {
+		renderHTTPRequest(r, v, &req)
+	} else if res, ok := AsConcrete[http.Response](v); ok {
+		renderHTTPResponse(r, v, &res)
+	} else if h, ok := AsConcrete[http.Header](v); ok {
+		if h != nil {
+			redact := !configOf(r).RenderSensitiveData
+			renderStringListMap(r, v, h, func(k string) bool {
+				_, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]
+				return redact && ok
+			})
+		}
+	} else if q, ok := AsConcrete[url.Values](v); ok {
+		if q != nil {
+			renderStringListMap(r, v, q, func(string) bool { return false })
+		}
+	}
+}
+
+func renderHTTPRequest(r Renderer, v Value, req *http.Request) {
+	renderHTTPFields(
+		r,
+		v,
+		"Method", req.Method,
+		"URL", req.URL,
+		"Proto", req.Proto,
+		"Header", req.Header,
+		"ContentLength", req.ContentLength,
+		"Body", formatHTTPBody(req.Body),
+	)
+}
+
+func renderHTTPResponse(r Renderer, v Value, res *http.Response) {
+	renderHTTPFields(
+		r,
+		v,
+		"Status", res.Status,
+		"Proto", res.Proto,
+		"Header", res.Header,
+		"ContentLength", res.ContentLength,
+		"Body", formatHTTPBody(res.Body),
+	)
+}
+
+// httpBodyPlaceholder is a pre-formatted description of a request or
+// response body.
+type httpBodyPlaceholder string
+
+// formatHTTPBody returns a placeholder describing body, without reading it.
+func formatHTTPBody(body any) httpBodyPlaceholder {
+	switch body {
+	case nil:
+		return "nil"
+	case http.NoBody:
+		return "<empty>"
+	default:
+		return "<body>"
+	}
+}
+
+// renderHTTPFields renders a struct-like list of name/value pairs.
+func renderHTTPFields(r Renderer, v Value, pairs ...any) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	alignment := 0
+	for i := 0; i < len(pairs); i += 2 {
+		if n := len(pairs[i].(string)); n > alignment {
+			alignment = n
+		}
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for i := 0; i < len(pairs); i += 2 {
+		name := pairs[i].(string)
+		r.Print("%s: %s", name, strings.Repeat(" ", alignment-len(name)))
+
+		if p, ok := pairs[i+1].(httpBodyPlaceholder); ok {
+			r.Print("%s", p)
+		} else {
+			fv := reflect.ValueOf(pairs[i+1])
+			r.WriteValue(
+				Value{
+					Value:                  fv,
+					DynamicType:            fv.Type(),
+					StaticType:             fv.Type(),
+					IsAmbiguousDynamicType: false,
+					IsAmbiguousStaticType:  false,
+					IsUnexported:           v.IsUnexported,
+				},
+			)
+		}
+
+		r.Print("\n")
+	}
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// renderStringListMap renders a map of strings to lists of strings, such as
+// [http.Header] or [url.Values], with each list rendered on a single line.
+//
+// The values of any keys for which redact returns true are replaced with a
+// marker.
+func renderStringListMap(
+	r Renderer,
+	v Value,
+	m map[string][]string,
+	redact func(string) bool,
+) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	if len(m) == 0 {
+		r.Print("{}")
+		return
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(
+		keys,
+		func(i, j int) bool {
+			return natsort.Less(keys[i], keys[j])
+		},
+	)
+
+	n := elementLimit(r, len(keys))
+	keys = keys[:n]
+
+	alignment := 0
+	for _, k := range keys {
+		if q := strconv.Quote(k); len(q) > alignment {
+			alignment = len(q)
+		}
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for _, k := range keys {
+		q := strconv.Quote(k)
+		r.Print("%s: %s", q, strings.Repeat(" ", alignment-len(q)))
+
+		if redact(k) {
+			r.Print("%s\n", redactedMarker)
+			continue
+		}
+
+		values := make([]string, len(m[k]))
+		for i, x := range m[k] {
+			values[i] = strconv.Quote(x)
+		}
+
+		r.Print("{%s}\n", strings.Join(values, ", "))
+	}
+
+	renderMoreElements(r, len(m)-n)
+
+	r.Outdent()
+	r.Print("}")
+}
@@ -0,0 +1,116 @@
+package dapper_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+func TestPrinter_HTTPFilter(t *testing.T) {
+	header := http.Header{
+		"Accept":        {"text/html", "application/json"},
+		"Authorization": {"Bearer secret"},
+		"X-Request-Id":  {"abc"},
+	}
+
+	test(
+		t,
+		"http.Header",
+		header,
+		`net/http.Header{`,
+		`    "Accept":        {"text/html", "application/json"}`,
+		`    "Authorization": <redacted>`,
+		`    "X-Request-Id":  {"abc"}`,
+		`}`,
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithSensitiveData(true)),
+		"http.Header (sensitive data)",
+		header,
+		`net/http.Header{`,
+		`    "Accept":        {"text/html", "application/json"}`,
+		`    "Authorization": {"Bearer secret"}`,
+		`    "X-Request-Id":  {"abc"}`,
+		`}`,
+	)
+
+	test(t, "http.Header (empty)", http.Header{}, `net/http.Header{}`)
+	test(t, "http.Header (nil)", http.Header(nil), `net/http.Header(nil)`)
+
+	test(
+		t,
+		"url.Values",
+		url.Values{"q": {"1", "2"}, "page": {"3"}},
+		`net/url.Values{`,
+		`    "page": {"3"}`,
+		`    "q":    {"1", "2"}`,
+		`}`,
+	)
+
+	req, _ := http.NewRequest(
+		http.MethodPost,
+		"https://example.com/path",
+		strings.NewReader("hello!"),
+	)
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Cookie", "session=secret")
+
+	test(
+		t,
+		"*http.Request",
+		req,
+		`*net/http.Request{`,
+		`    Method:        "POST"`,
+		`    URL:           https://example.com/path`,
+		`    Proto:         "HTTP/1.1"`,
+		`    Header:        {`,
+		`        "Content-Type": {"text/plain"}`,
+		`        "Cookie":       <redacted>`,
+		`    }`,
+		`    ContentLength: 6`,
+		`    Body:          <body>`,
+		`}`,
+	)
+
+	test(
+		t,
+		"http.Request (non-addressable)",
+		http.Request{Method: http.MethodGet},
+		`net/http.Request{`,
+		`    Method:        "GET"`,
+		`    URL:           nil`,
+		`    Proto:         ""`,
+		`    Header:        nil`,
+		`    ContentLength: 0`,
+		`    Body:          nil`,
+		`}`,
+	)
+
+	res := &http.Response{
+		Status:        "204 No Content",
+		Proto:         "HTTP/1.1",
+		Header:        http.Header{"Set-Cookie": {"session=secret"}},
+		ContentLength: 0,
+		Body:          http.NoBody,
+	}
+
+	test(
+		t,
+		"*http.Response",
+		res,
+		`*net/http.Response{`,
+		`    Status:        "204 No Content"`,
+		`    Proto:         "HTTP/1.1"`,
+		`    Header:        {`,
+		`        "Set-Cookie": <redacted>`,
+		`    }`,
+		`    ContentLength: 0`,
+		`    Body:          <empty>`,
+		`}`,
+	)
+}
@@ -0,0 +1,185 @@
+package dapper
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// JSONFilter is a [Filter] that formats types from the [encoding/json]
+// package.
+//
+// [json.RawMessage] values are parsed and rendered as a tree of objects,
+// arrays and scalars, preserving the order of object members. Invalid JSON is
+// rendered as a quoted string. [json.Number] values are rendered as bare
+// numbers.
+func JSONFilter(r Renderer, v Value) {
+	if m, ok := AsConcrete[json.RawMessage](v); ok {
+		if m != nil {
+			renderJSON(r, v, m)
+		}
+	} else if n, ok := AsConcrete[json.Number](v); ok {
+		if json.Valid([]byte(n)) {
+			printWithTypeIfAmbiguous(r, v, "%s", n)
+		} else {
+			printWithTypeIfAmbiguous(r, v, "%q", n)
+		}
+	}
+}
+
+// jsonMember is a member of a JSON object.
+type jsonMember struct {
+	Key   string
+	Value any
+}
+
+func renderJSON(r Renderer, v Value, m json.RawMessage) {
+	node, ok := parseJSON(m)
+	if !ok {
+		printWithTypeIfAmbiguous(r, v, "%q", string(m))
+		return
+	}
+
+	renderJSONTree(r, v, node)
+}
+
+// renderJSONTree renders a parsed JSON node as the value of v.
+func renderJSONTree(r Renderer, v Value, node any) {
+	switch node.(type) {
+	case []jsonMember, []any:
+		if v.IsAmbiguousType() {
+			r.WriteType(v)
+		}
+		renderJSONNode(r, node)
+	default:
+		if v.IsAmbiguousType() {
+			r.WriteType(v)
+			r.Print("(")
+			renderJSONNode(r, node)
+			r.Print(")")
+		} else {
+			renderJSONNode(r, node)
+		}
+	}
+}
+
+// parseJSON parses data into a tree of JSON nodes.
+//
+// Objects are represented as []jsonMember, arrays as []any, numbers as
+// [json.Number] and null as nil. Strings and booleans use their Go types.
+func parseJSON(data []byte) (any, bool) {
+	if !json.Valid(data) {
+		return nil, false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	node, err := parseJSONNode(dec)
+	return node, err == nil
+}
+
+func parseJSONNode(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		members := []jsonMember{}
+
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			x, err := parseJSONNode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			members = append(members, jsonMember{k.(string), x})
+		}
+
+		_, err := dec.Token() // consume closing brace
+		return members, err
+
+	case json.Delim('['):
+		elements := []any{}
+
+		for dec.More() {
+			x, err := parseJSONNode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, x)
+		}
+
+		_, err := dec.Token() // consume closing bracket
+		return elements, err
+	}
+
+	return tok, nil
+}
+
+// renderJSONNode renders a parsed JSON node.
+func renderJSONNode(r Renderer, node any) {
+	switch n := node.(type) {
+	case nil:
+		r.Print("nil")
+	case string:
+		r.Print("%q", n)
+	case json.Number:
+		r.Print("%s", n)
+	case bool:
+		r.Print("%t", n)
+	case []any:
+		if len(n) == 0 {
+			r.Print("{}")
+			return
+		}
+
+		limit := elementLimit(r, len(n))
+
+		r.Print("{\n")
+		r.Indent()
+		for _, x := range n[:limit] {
+			renderJSONNode(r, x)
+			r.Print("\n")
+		}
+		renderMoreElements(r, len(n)-limit)
+		r.Outdent()
+		r.Print("}")
+	case []jsonMember:
+		if len(n) == 0 {
+			r.Print("{}")
+			return
+		}
+
+		limit := elementLimit(r, len(n))
+		alignment := 0
+		keys := make([]string, limit)
+
+		for i, m := range n[:limit] {
+			keys[i] = strconv.Quote(m.Key)
+			if len(keys[i]) > alignment {
+				alignment = len(keys[i])
+			}
+		}
+
+		r.Print("{\n")
+		r.Indent()
+		for i, m := range n[:limit] {
+			r.Print("%s: %s", keys[i], strings.Repeat(" ", alignment-len(keys[i])))
+			renderJSONNode(r, m.Value)
+			r.Print("\n")
+		}
+		renderMoreElements(r, len(n)-limit)
+		r.Outdent()
+		r.Print("}")
+	}
+}
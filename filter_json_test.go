@@ -0,0 +1,86 @@
+package dapper_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+func TestPrinter_JSONFilter(t *testing.T) {
+	// json.RawMessage is an alias for jsontext.Value in some Go versions.
+	rt := reflect.TypeFor[json.RawMessage]()
+	raw := rt.PkgPath() + "." + rt.Name()
+
+	test(
+		t,
+		"json.RawMessage (object)",
+		json.RawMessage(`{"name":"dapper","tags":["a","b"],"count":3,"ok":true,"none":null,"empty":{}}`),
+		raw+`{`,
+		`    "name":  "dapper"`,
+		`    "tags":  {`,
+		`        "a"`,
+		`        "b"`,
+		`    }`,
+		`    "count": 3`,
+		`    "ok":    true`,
+		`    "none":  nil`,
+		`    "empty": {}`,
+		`}`,
+	)
+
+	test(
+		t,
+		"json.RawMessage (array)",
+		json.RawMessage(`[1, 2.5, 12345678901234567890]`),
+		raw+`{`,
+		`    1`,
+		`    2.5`,
+		`    12345678901234567890`,
+		`}`,
+	)
+
+	test(t, "json.RawMessage (scalar)", json.RawMessage(`"hello"`), raw+`("hello")`)
+	test(t, "json.RawMessage (invalid)", json.RawMessage(`{"a":`), raw+`("{\"a\":")`)
+	test(t, "json.RawMessage (nil)", json.RawMessage(nil), raw+`(nil)`)
+	test(t, "json.Number", json.Number("1.5e3"), `encoding/json.Number(1.5e3)`)
+	test(t, "json.Number (invalid)", json.Number("abc"), `encoding/json.Number("abc")`)
+
+	type payload struct {
+		Body json.RawMessage
+		N    json.Number
+	}
+
+	test(
+		t,
+		"non-ambiguous types",
+		payload{
+			Body: json.RawMessage(`{"a":1}`),
+			N:    json.Number("42"),
+		},
+		`github.com/dogmatiq/dapper_test.payload{`,
+		`    Body: {`,
+		`        "a": 1`,
+		`    }`,
+		`    N:    42`,
+		`}`,
+	)
+}
+
+func TestPrinter_JSONFilter_limits(t *testing.T) {
+	rt := reflect.TypeFor[json.RawMessage]()
+	raw := rt.PkgPath() + "." + rt.Name()
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxDepth(1), WithMaxElements(2)),
+		"json.RawMessage",
+		json.RawMessage(`{"tags":["a","b"],"count":3,"ok":true}`),
+		raw+`{`,
+		`    "tags":  {...}`,
+		`    "count": 3`,
+		`    <1 more element(s)>`,
+		`}`,
+	)
+}
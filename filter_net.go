@@ -0,0 +1,39 @@
+package dapper
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+// NetFilter is a [Filter] that formats IP addresses, network prefixes,
+// hardware addresses and URLs from the [net], [net/netip] and [net/url]
+// packages using their canonical text form.
+//
+// Passwords within URLs are redacted unless [Config.RenderSensitiveData] is
+// true.
+func NetFilter(r Renderer, v Value) {
+	if ip, ok := AsConcrete[net.IP](v); ok {
+		if ip != nil {
+			printWithTypeIfAmbiguous(r, v, "%s", ip)
+		}
+	} else if n, ok := AsConcrete[net.IPNet](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", n.String())
+	} else if a, ok := AsConcrete[net.HardwareAddr](v); ok {
+		if a != nil {
+			printWithTypeIfAmbiguous(r, v, "%s", a)
+		}
+	} else if a, ok := AsConcrete[netip.Addr](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", a)
+	} else if a, ok := AsConcrete[netip.AddrPort](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", a)
+	} else if p, ok := AsConcrete[netip.Prefix](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", p)
+	} else if u, ok := AsConcrete[url.URL](v); ok {
+		s := u.Redacted()
+		if configOf(r).RenderSensitiveData {
+			s = u.String()
+		}
+		printWithTypeIfAmbiguous(r, v, "%s", s)
+	}
+}
@@ -1,16 +1,288 @@
 package dapper
 
-import "google.golang.org/protobuf/proto"
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
-// ProtoFilter is a [Filter] that formats implementations of [proto.Message].
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// unknownProtoEnumMarker is the string to display after the number of a
+// protocol buffers enum value that is not defined by the enum.
+const unknownProtoEnumMarker = "<unknown enum value>"
+
+// ProtoFilter is a [Filter] that formats implementations of [proto.Message]
+// and [protoreflect.Enum].
+//
+// Messages are rendered via [protoreflect] rather than as the generated Go
+// struct. Populated fields are rendered using their proto field names in
+// declaration order, followed by any populated extensions. Only the populated
+// field of each oneof is rendered, and map fields are sorted by key. Unknown
+// fields are listed after the message.
+//
+// Well-known types, such as google.protobuf.Timestamp and
+// google.protobuf.Struct, are rendered like their natural Go equivalents.
+//
+// Enum values are rendered by name, both within messages and standalone.
 func ProtoFilter(r Renderer, v Value) {
-	if _, ok := AsImplementationOf[proto.Message](v); ok {
-		r.
-			WithModifiedConfig(
-				func(c *Config) {
-					c.RenderUnexportedStructFields = false
-				},
-			).
-			WriteValue(v)
+	if e, ok := AsImplementationOf[protoreflect.Enum](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", formatProtoEnum(e.Descriptor(), e.Number()))
+		return
+	}
+
+	m, ok := AsImplementationOf[proto.Message](v)
+	if !ok {
+		return
+	}
+
+	if v.DynamicType.Kind() == reflect.Ptr && v.Value.IsNil() {
+		return
+	}
+
+	pm := m.ProtoReflect()
+
+	if !renderProtoWellKnownType(r, v, pm) {
+		renderProtoMessage(r, v, pm)
+	}
+}
+
+func renderProtoMessage(r Renderer, v Value, m protoreflect.Message) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	fields := populatedProtoFields(m)
+
+	if len(fields) == 0 {
+		if m.Descriptor().Fields().Len() == 0 {
+			r.Print("{}")
+		} else {
+			r.Print("{%s}", zeroValueMarker)
+		}
+	} else {
+		names := make([]string, len(fields))
+		alignment := 0
+
+		for i, fd := range fields {
+			if fd.IsExtension() {
+				names[i] = "[" + string(fd.FullName()) + "]"
+			} else {
+				names[i] = string(fd.Name())
+			}
+
+			if len(names[i]) > alignment {
+				alignment = len(names[i])
+			}
+		}
+
+		r.Print("{\n")
+		r.Indent()
+
+		for i, fd := range fields {
+			r.Print("%s: %s", names[i], strings.Repeat(" ", alignment-len(names[i])))
+			renderProtoValue(r, v, fd, m.Get(fd))
+			r.Print("\n")
+		}
+
+		r.Outdent()
+		r.Print("}")
 	}
+
+	if u := m.GetUnknown(); len(u) != 0 {
+		r.Print(" <unknown fields: %s>", formatUnknownProtoFields(u))
+	}
+}
+
+// populatedProtoFields returns the populated fields of m in declaration
+// order, followed by its populated extensions sorted by name.
+func populatedProtoFields(m protoreflect.Message) []protoreflect.FieldDescriptor {
+	var fields, extensions []protoreflect.FieldDescriptor
+
+	fds := m.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		if fd := fds.Get(i); m.Has(fd) {
+			fields = append(fields, fd)
+		}
+	}
+
+	m.Range(
+		func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fd.IsExtension() {
+				extensions = append(extensions, fd)
+			}
+			return true
+		},
+	)
+
+	sort.Slice(
+		extensions,
+		func(i, j int) bool {
+			return extensions[i].FullName() < extensions[j].FullName()
+		},
+	)
+
+	return append(fields, extensions...)
+}
+
+// renderProtoValue renders the value of the field described by fd. v is the
+// message that contains the field.
+func renderProtoValue(
+	r Renderer,
+	v Value,
+	fd protoreflect.FieldDescriptor,
+	pv protoreflect.Value,
+) {
+	if fd.IsList() {
+		l := pv.List()
+
+		n := elementLimit(r, l.Len())
+
+		r.Print("{\n")
+		r.Indent()
+
+		for i := 0; i < n; i++ {
+			renderProtoSingular(r, v, fd, l.Get(i))
+			r.Print("\n")
+		}
+
+		renderMoreElements(r, l.Len()-n)
+
+		r.Outdent()
+		r.Print("}")
+	} else if fd.IsMap() {
+		renderProtoMap(r, v, fd, pv.Map())
+	} else {
+		renderProtoSingular(r, v, fd, pv)
+	}
+}
+
+func renderProtoMap(
+	r Renderer,
+	v Value,
+	fd protoreflect.FieldDescriptor,
+	m protoreflect.Map,
+) {
+	var keys []protoreflect.MapKey
+
+	m.Range(
+		func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, k)
+			return true
+		},
+	)
+
+	sort.Slice(
+		keys,
+		func(i, j int) bool {
+			return lessProtoMapKey(keys[i], keys[j])
+		},
+	)
+
+	n := elementLimit(r, len(keys))
+	keys = keys[:n]
+
+	formatted := make([]string, len(keys))
+	alignment := 0
+
+	for i, k := range keys {
+		formatted[i] = r.FormatValue(protoGoValue(v, k.Interface()))
+
+		if len(formatted[i]) > alignment {
+			alignment = len(formatted[i])
+		}
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for i, k := range keys {
+		r.Print("%s: %s", formatted[i], strings.Repeat(" ", alignment-len(formatted[i])))
+		renderProtoSingular(r, v, fd.MapValue(), m.Get(k))
+		r.Print("\n")
+	}
+
+	renderMoreElements(r, m.Len()-n)
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// lessProtoMapKey returns true if a should be sorted before b.
+func lessProtoMapKey(a, b protoreflect.MapKey) bool {
+	switch x := a.Interface().(type) {
+	case bool:
+		return !x && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	case uint32, uint64:
+		return a.Uint() < b.Uint()
+	default:
+		return a.String() < b.String()
+	}
+}
+
+// renderProtoSingular renders a single (non-list, non-map) value of the field
+// described by fd.
+func renderProtoSingular(
+	r Renderer,
+	v Value,
+	fd protoreflect.FieldDescriptor,
+	pv protoreflect.Value,
+) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		r.WriteValue(protoGoValue(v, pv.Message().Interface()))
+	case protoreflect.EnumKind:
+		r.Print("%s", formatProtoEnum(fd.Enum(), pv.Enum()))
+	default:
+		r.WriteValue(protoGoValue(v, pv.Interface()))
+	}
+}
+
+// formatProtoEnum returns the name of the value n within the enum described by
+// ed.
+func formatProtoEnum(ed protoreflect.EnumDescriptor, n protoreflect.EnumNumber) string {
+	if ev := ed.Values().ByNumber(n); ev != nil {
+		return string(ev.Name())
+	}
+
+	return fmt.Sprintf("%d %s", n, unknownProtoEnumMarker)
+}
+
+// protoGoValue returns a [Value] for x, which is a Go representation of a
+// value within the message v.
+func protoGoValue(v Value, x any) Value {
+	rv := reflect.ValueOf(x)
+
+	return Value{
+		Value:                  rv,
+		DynamicType:            rv.Type(),
+		StaticType:             rv.Type(),
+		IsAmbiguousDynamicType: false,
+		IsAmbiguousStaticType:  false,
+		IsUnexported:           v.IsUnexported,
+	}
+}
+
+// formatUnknownProtoFields returns a description of the unknown fields in b,
+// listing their field numbers.
+func formatUnknownProtoFields(b protoreflect.RawFields) string {
+	var numbers []string
+
+	for len(b) > 0 {
+		num, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return fmt.Sprintf("%d malformed byte(s)", len(b))
+		}
+
+		numbers = append(numbers, strconv.Itoa(int(num)))
+		b = b[n:]
+	}
+
+	return strings.Join(numbers, ", ")
 }
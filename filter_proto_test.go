@@ -1,13 +1,15 @@
 package dapper_test
 
 import (
-	"regexp"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/dogmatiq/dapper"
 	"github.com/dogmatiq/dapper/internal/fixtures"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestPrinter_ProtoFilter(t *testing.T) {
@@ -32,19 +34,19 @@ func TestPrinter_ProtoFilter(t *testing.T) {
 			actual := dapper.Format(m)
 			expected := strings.Join([]string{
 				`*github.com/dogmatiq/dapper/internal/fixtures.Message{`,
-				`    Str:     "hello"`,
-				`    Enum:     1`,
-				`    Nested:   {`,
-				`        NestedA: "foo"`,
-				`        NestedB: {`,
+				`    str:      "hello"`,
+				`    enum:     FOO`,
+				`    nested:   {`,
+				`        nested_a: "foo"`,
+				`        nested_b: {`,
 				`            00000000  3c 62 79 74 65 73 3e                              |<bytes>|`,
 				`        }`,
 				`    }`,
-				`    Stringer: [<stringer>]`,
+				`    stringer: [<stringer>]`,
 				`}`,
 			}, "\n")
 
-			if !matchProtoFormat(actual, expected) {
+			if actual != expected {
 				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
 			}
 		},
@@ -81,20 +83,155 @@ func TestPrinter_ProtoFilter(t *testing.T) {
 				`{`,
 				`    foo: "hi"`,
 				`    bar: *github.com/dogmatiq/dapper/internal/fixtures.Message{`,
-				`        Str:      "hello"`,
-				`        Enum:     0`,
-				`        Nested:   nil`,
-				`        Stringer: [<stringer>]`,
+				`        str:      "hello"`,
+				`        stringer: [<stringer>]`,
+				`    }`,
+				`}`,
+			}, "\n")
+
+			if actual != expected {
+				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
+			}
+		},
+	)
+
+	t.Run(
+		"it renders repeated, map and oneof fields", func(t *testing.T) {
+			m := &fixtures.Composite{
+				List: []string{"a", "b"},
+				Map: map[string]*fixtures.Nested{
+					"y": {NestedA: "2"},
+					"x": {NestedA: "1"},
+				},
+				IntMap: map[int32]string{
+					10: "ten",
+					-1: "minus one",
+					2:  "two",
+				},
+				Choice: &fixtures.Composite_ChoiceStr{
+					ChoiceStr: "chosen",
+				},
+			}
+
+			actual := dapper.Format(m)
+			expected := strings.Join([]string{
+				`*github.com/dogmatiq/dapper/internal/fixtures.Composite{`,
+				`    list:       {`,
+				`        "a"`,
+				`        "b"`,
+				`    }`,
+				`    map:        {`,
+				`        "x": {`,
+				`            nested_a: "1"`,
+				`        }`,
+				`        "y": {`,
+				`            nested_a: "2"`,
+				`        }`,
+				`    }`,
+				`    int_map:    {`,
+				`        -1: "minus one"`,
+				`        2:  "two"`,
+				`        10: "ten"`,
 				`    }`,
+				`    choice_str: "chosen"`,
 				`}`,
 			}, "\n")
 
-			if !matchProtoFormat(actual, expected) {
+			if actual != expected {
 				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
 			}
 		},
 	)
 
+	t.Run(
+		"it renders fields with explicit presence that are set to their default value", func(t *testing.T) {
+			m := &fixtures.Composite{
+				OptionalInt: proto.Int32(0),
+				Choice: &fixtures.Composite_ChoiceNested{
+					ChoiceNested: &fixtures.Nested{},
+				},
+			}
+
+			actual := dapper.Format(m)
+			expected := strings.Join([]string{
+				`*github.com/dogmatiq/dapper/internal/fixtures.Composite{`,
+				`    optional_int:  0`,
+				`    choice_nested: {<zero>}`,
+				`}`,
+			}, "\n")
+
+			if actual != expected {
+				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
+			}
+		},
+	)
+
+	t.Run(
+		"it renders unknown fields as an annotation", func(t *testing.T) {
+			m := &fixtures.Message{
+				Str: "hello",
+			}
+
+			var unknown []byte
+			unknown = protowire.AppendTag(unknown, 10, protowire.VarintType)
+			unknown = protowire.AppendVarint(unknown, 123)
+			unknown = protowire.AppendTag(unknown, 11, protowire.BytesType)
+			unknown = protowire.AppendBytes(unknown, []byte("foo"))
+			m.ProtoReflect().SetUnknown(unknown)
+
+			actual := dapper.Format(m)
+			expected := strings.Join([]string{
+				`*github.com/dogmatiq/dapper/internal/fixtures.Message{`,
+				`    str: "hello"`,
+				`} <unknown fields: 10, 11>`,
+			}, "\n")
+
+			if actual != expected {
+				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
+			}
+		},
+	)
+
+	t.Run(
+		"it renders enum values by name", func(t *testing.T) {
+			m := &fixtures.Composite{
+				Enums: []fixtures.Enum{
+					fixtures.Enum_BAR,
+					fixtures.Enum(100),
+				},
+			}
+
+			actual := dapper.Format(m)
+			expected := strings.Join([]string{
+				`*github.com/dogmatiq/dapper/internal/fixtures.Composite{`,
+				`    enums: {`,
+				`        BAR`,
+				`        100 <unknown enum value>`,
+				`    }`,
+				`}`,
+			}, "\n")
+
+			if actual != expected {
+				t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
+			}
+		},
+	)
+
+	t.Run(
+		"it renders standalone enum values by name", func(t *testing.T) {
+			cases := map[fixtures.Enum]string{
+				fixtures.Enum_FOO:  `github.com/dogmatiq/dapper/internal/fixtures.Enum(FOO)`,
+				fixtures.Enum(100): `github.com/dogmatiq/dapper/internal/fixtures.Enum(100 <unknown enum value>)`,
+			}
+
+			for e, expected := range cases {
+				if actual := dapper.Format(e); actual != expected {
+					t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
+				}
+			}
+		},
+	)
+
 	t.Run(
 		"it performs adequately with internal state set", func(t *testing.T) {
 			m := &fixtures.Message{
@@ -119,20 +256,30 @@ func TestPrinter_ProtoFilter(t *testing.T) {
 	)
 }
 
-// matchProtoFormat works around non-deterministic behaviour introduced by the
-// protobuf package.
-//
-// The protobuf maintainers want to enforce nobody relies on the output being
-// stable. It's not unreasonable but Dapper is all about the output, so we
-// really want to know when it changes.
-//
-// See https://github.com/golang/protobuf/issues/1121.
-func matchProtoFormat(actual, expected string) bool {
-	pattern := strings.ReplaceAll(regexp.QuoteMeta(expected), ": ", ":  ?")
-	ok, err := regexp.MatchString(pattern, actual)
-
-	if err != nil {
-		panic(err)
+func TestPrinter_ProtoFilter_limits(t *testing.T) {
+	m := &fixtures.Composite{
+		List: []string{"a", "b", "c"},
+		Map: map[string]*fixtures.Nested{
+			"x": {NestedA: "foo"},
+			"y": {NestedA: "bar"},
+		},
+	}
+
+	actual := fmt.Sprintf("%+2.1v", dapper.Wrap(m))
+	expected := strings.Join([]string{
+		`*github.com/dogmatiq/dapper/internal/fixtures.Composite{`,
+		`    list: {`,
+		`        "a"`,
+		`        <2 more element(s)>`,
+		`    }`,
+		`    map:  {`,
+		`        "x": {...}`,
+		`        <1 more element(s)>`,
+		`    }`,
+		`}`,
+	}, "\n")
+
+	if actual != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, actual)
 	}
-	return ok
 }
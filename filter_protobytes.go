@@ -0,0 +1,263 @@
+package dapper
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// WithProtoBytes adds a [Filter] that renders values of type T as the binary
+// protocol buffers message described by md.
+//
+// If md is nil, or the value can not be unmarshaled as md, it is rendered as a
+// best-effort dump of the fields in the protocol buffers wire format.
+func WithProtoBytes[T ~[]byte](md protoreflect.MessageDescriptor) Option {
+	return WithFilter(
+		func(r Renderer, v Value) {
+			if Is[T](v) {
+				renderProtoBytes(r, v, md)
+			}
+		},
+	)
+}
+
+// WithProtoBytesField renders the field with the given name within struct type
+// S as the binary protocol buffers message described by md.
+//
+// If md is nil, or the value can not be unmarshaled as md, it is rendered as a
+// best-effort dump of the fields in the protocol buffers wire format.
+//
+// It panics if S does not have a field with the given name that is a byte
+// slice.
+func WithProtoBytesField[S any](name string, md protoreflect.MessageDescriptor) Option {
+	t := typeOf[S]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("%s is not a struct", t))
+	}
+
+	f, ok := t.FieldByName(name)
+	if !ok || f.Type.Kind() != reflect.Slice || f.Type.Elem().Kind() != reflect.Uint8 {
+		panic(fmt.Sprintf("%s does not have a byte slice field named %q", t, name))
+	}
+
+	return func(cfg *Config) {
+		if cfg.protoBytesFields == nil {
+			cfg.protoBytesFields = map[reflect.Type]map[string]protoreflect.MessageDescriptor{}
+			cfg.Filters = append(cfg.Filters, protoBytesFieldFilter)
+		}
+
+		// The fields of each struct are copied, as the outer map is only
+		// shallow-copied when the configuration is cloned.
+		fields := maps.Clone(cfg.protoBytesFields[t])
+		if fields == nil {
+			fields = map[string]protoreflect.MessageDescriptor{}
+		}
+
+		fields[name] = md
+		cfg.protoBytesFields[t] = fields
+	}
+}
+
+// ProtoMessageDescriptor returns the descriptor of the message with the given
+// full name from a set of file descriptors, such as one produced by protoc's
+// --descriptor_set_out flag.
+//
+// It is intended for use with [WithProtoBytes] and [WithProtoBytesField].
+func ProtoMessageDescriptor(
+	set *descriptorpb.FileDescriptorSet,
+	name protoreflect.FullName,
+) (protoreflect.MessageDescriptor, error) {
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return md, nil
+}
+
+// protoBytesFieldFilter is a [Filter] that renders structs with fields that
+// have been registered using [WithProtoBytesField].
+func protoBytesFieldFilter(r Renderer, v Value) {
+	fields, ok := configOf(r).protoBytesFields[v.DynamicType]
+	if !ok {
+		return
+	}
+
+	renderStruct(
+		r,
+		v,
+		func(r Renderer, f reflect.StructField) Renderer {
+			md, ok := fields[f.Name]
+			if !ok {
+				return r
+			}
+
+			// The filter must only apply to the field itself, and not to any
+			// byte slices within the message it contains.
+			applied := false
+
+			return r.WithModifiedConfig(
+				func(c *Config) {
+					c.Filters = append(
+						[]Filter{
+							func(r Renderer, v Value) {
+								if !applied {
+									applied = true
+									renderProtoBytes(r, v, md)
+								}
+							},
+						},
+						c.Filters...,
+					)
+				},
+			)
+		},
+	)
+}
+
+// renderProtoBytes renders the byte slice v as the binary protocol buffers
+// message described by md, if possible. Otherwise, it renders a dump of the
+// fields in the protocol buffers wire format.
+//
+// If v is nil, or can not be parsed at all, it is left to the default
+// rendering logic.
+func renderProtoBytes(r Renderer, v Value, md protoreflect.MessageDescriptor) {
+	if v.Value.IsNil() {
+		return
+	}
+
+	data := v.Value.Bytes()
+
+	if md != nil {
+		m := dynamicpb.NewMessage(md)
+
+		if err := proto.Unmarshal(data, m); err == nil {
+			renderProtoPayload(r, v, m)
+			return
+		}
+	}
+
+	if !isProtoWireFormat(data) {
+		return
+	}
+
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	renderProtoWireFormat(r, v, data)
+}
+
+// isProtoWireFormat returns true if data can be parsed as a sequence of
+// fields in the protocol buffers wire format.
+func isProtoWireFormat(data []byte) bool {
+	for len(data) > 0 {
+		_, _, n := protowire.ConsumeField(data)
+		if n < 0 {
+			return false
+		}
+		data = data[n:]
+	}
+
+	return true
+}
+
+// renderProtoWireFormat renders a dump of the fields in data, which must be
+// valid protocol buffers wire format.
+func renderProtoWireFormat(r Renderer, v Value, data []byte) {
+	if len(data) == 0 {
+		r.Print("{}")
+		return
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		data = data[n:]
+
+		r.Print("%d: ", num)
+
+		switch typ {
+		case protowire.VarintType:
+			x, n := protowire.ConsumeVarint(data)
+			data = data[n:]
+			r.Print("%d", x)
+
+		case protowire.Fixed32Type:
+			x, n := protowire.ConsumeFixed32(data)
+			data = data[n:]
+			r.Print("0x%08x <fixed32>", x)
+
+		case protowire.Fixed64Type:
+			x, n := protowire.ConsumeFixed64(data)
+			data = data[n:]
+			r.Print("0x%016x <fixed64>", x)
+
+		case protowire.BytesType:
+			x, n := protowire.ConsumeBytes(data)
+			data = data[n:]
+			renderProtoWireBytes(r, v, x)
+
+		case protowire.StartGroupType:
+			x, n := protowire.ConsumeGroup(num, data)
+			data = data[n:]
+			renderProtoWireFormat(r, v, x)
+		}
+
+		r.Print("\n")
+	}
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// renderProtoWireBytes renders a length-delimited field value. It is rendered
+// as a string if it is printable text, as a nested message if it can be parsed
+// as such, and as a byte slice otherwise.
+func renderProtoWireBytes(r Renderer, v Value, data []byte) {
+	if isPrintableText(data) {
+		r.Print("%s", strconv.Quote(string(data)))
+	} else if isProtoWireFormat(data) {
+		renderProtoWireFormat(r, v, data)
+	} else {
+		r.WriteValue(protoGoValue(v, data))
+	}
+}
+
+// isPrintableText returns true if data is non-empty, valid UTF-8 text that
+// consists only of printable characters and whitespace.
+func isPrintableText(data []byte) bool {
+	if len(data) == 0 || !utf8.Valid(data) {
+		return false
+	}
+
+	for _, c := range string(data) {
+		if !unicode.IsPrint(c) && !unicode.IsSpace(c) {
+			return false
+		}
+	}
+
+	return true
+}
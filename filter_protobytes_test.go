@@ -0,0 +1,174 @@
+package dapper_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+	"github.com/dogmatiq/dapper/internal/fixtures"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type protoPayload []byte
+
+type protoEnvelope struct {
+	Kind string
+	Data []byte
+}
+
+func TestPrinter_WithProtoBytes(t *testing.T) {
+	data, err := proto.Marshal(
+		&fixtures.Message{
+			Str: "hello",
+			Nested: &fixtures.Nested{
+				NestedB: []byte{0xff},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	md := (&fixtures.Message{}).ProtoReflect().Descriptor()
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithProtoBytes[protoPayload](md)),
+		"registered type",
+		protoPayload(data),
+		`github.com/dogmatiq/dapper_test.protoPayload(dogmatiq.dapper.fixtures.Message{`,
+		`    str:    "hello"`,
+		`    nested: {`,
+		`        nested_b: {`,
+		`            00000000  ff                                                |.|`,
+		`        }`,
+		`    }`,
+		`})`,
+	)
+
+	ts, err := proto.Marshal(
+		timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testWithPrinter(
+		t,
+		NewPrinter(
+			WithProtoBytes[protoPayload](
+				(&timestamppb.Timestamp{}).ProtoReflect().Descriptor(),
+			),
+		),
+		"registered well-known type",
+		protoPayload(ts),
+		`github.com/dogmatiq/dapper_test.protoPayload(google.protobuf.Timestamp(2020-01-02T03:04:05Z))`,
+	)
+
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(md.ParentFile()),
+		},
+	}
+
+	md, err = ProtoMessageDescriptor(set, "dogmatiq.dapper.fixtures.Message")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithProtoBytesField[protoEnvelope]("Data", md)),
+		"registered struct field",
+		protoEnvelope{"message", data},
+		`github.com/dogmatiq/dapper_test.protoEnvelope{`,
+		`    Kind: "message"`,
+		`    Data: dogmatiq.dapper.fixtures.Message{`,
+		`        str:    "hello"`,
+		`        nested: {`,
+		`            nested_b: {`,
+		`                00000000  ff                                                |.|`,
+		`            }`,
+		`        }`,
+		`    }`,
+		`}`,
+	)
+
+	var wire []byte
+	wire = protowire.AppendTag(wire, 1, protowire.VarintType)
+	wire = protowire.AppendVarint(wire, 150)
+	wire = protowire.AppendTag(wire, 2, protowire.BytesType)
+	wire = protowire.AppendString(wire, "hello")
+	wire = protowire.AppendTag(wire, 3, protowire.Fixed32Type)
+	wire = protowire.AppendFixed32(wire, 42)
+	wire = protowire.AppendTag(wire, 4, protowire.BytesType)
+	wire = protowire.AppendBytes(wire, data)
+	wire = protowire.AppendTag(wire, 5, protowire.BytesType)
+	wire = protowire.AppendBytes(wire, []byte{0xff})
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithProtoBytesField[protoEnvelope]("Data", nil)),
+		"wire format dump",
+		protoEnvelope{"message", wire},
+		`github.com/dogmatiq/dapper_test.protoEnvelope{`,
+		`    Kind: "message"`,
+		`    Data: {`,
+		`        1: 150`,
+		`        2: "hello"`,
+		`        3: 0x0000002a <fixed32>`,
+		`        4: {`,
+		`            1: "hello"`,
+		`            3: {`,
+		`                2: {`,
+		`                    00000000  ff                                                |.|`,
+		`                }`,
+		`            }`,
+		`        }`,
+		`        5: {`,
+		`            00000000  ff                                                |.|`,
+		`        }`,
+		`    }`,
+		`}`,
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithProtoBytes[protoPayload](nil)),
+		"invalid wire format",
+		protoPayload{0xff},
+		`github.com/dogmatiq/dapper_test.protoPayload{`,
+		`    00000000  ff                                                |.|`,
+		`}`,
+	)
+}
+
+func TestWithProtoBytesField_panicsIfFieldIsNotBytes(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+
+	WithProtoBytesField[protoEnvelope]("Kind", nil)
+}
+
+func TestProtoMessageDescriptor_notAMessage(t *testing.T) {
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(fixtures.File_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto),
+		},
+	}
+
+	if _, err := ProtoMessageDescriptor(set, "dogmatiq.dapper.fixtures.Enum"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if _, err := ProtoMessageDescriptor(set, "dogmatiq.dapper.fixtures.Unknown"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
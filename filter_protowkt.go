@@ -0,0 +1,183 @@
+package dapper
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// renderProtoWellKnownType renders m, which is the message in v, if it is one
+// of the protocol buffers well-known types. It returns false if m is not a
+// well-known type.
+//
+// Well-known types are rendered like their natural Go equivalents.
+func renderProtoWellKnownType(r Renderer, v Value, m protoreflect.Message) bool {
+	md := m.Descriptor()
+	if md.ParentFile().Package() != "google.protobuf" {
+		return false
+	}
+
+	switch md.Name() {
+	case "Timestamp":
+		t := time.Unix(
+			protoFieldByName(m, "seconds").Int(),
+			protoFieldByName(m, "nanos").Int(),
+		).UTC()
+
+		printWithTypeIfAmbiguous(r, v, "%s", formatTime(configOf(r), t))
+
+	case "Duration":
+		d := time.Duration(protoFieldByName(m, "seconds").Int())*time.Second +
+			time.Duration(protoFieldByName(m, "nanos").Int())
+
+		printWithTypeIfAmbiguous(r, v, "%s", d)
+
+	case "DoubleValue", "FloatValue",
+		"Int64Value", "UInt64Value",
+		"Int32Value", "UInt32Value",
+		"BoolValue", "StringValue", "BytesValue":
+		writeWithTypeIfAmbiguous(
+			r,
+			v,
+			protoGoValue(v, protoFieldByName(m, "value").Interface()),
+		)
+
+	case "Any":
+		return renderProtoAny(r, v, m)
+
+	case "Struct", "Value", "ListValue":
+		renderJSONTree(r, v, protoStructToJSON(m))
+
+	case "FieldMask":
+		paths := protoFieldByName(m, "paths").List()
+		node := make([]any, paths.Len())
+
+		for i := range node {
+			node[i] = paths.Get(i).String()
+		}
+
+		renderJSONTree(r, v, node)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// renderProtoAny renders the payload of m, which is a google.protobuf.Any
+// message, prefixed with the full name of the payload's message type. It
+// returns false if the type URL cannot be resolved or the payload cannot be
+// unmarshaled.
+func renderProtoAny(r Renderer, v Value, m protoreflect.Message) bool {
+	res := configOf(r).ProtoTypeResolver
+	if res == nil {
+		res = protoregistry.GlobalTypes
+	}
+
+	mt, err := res.FindMessageByURL(protoFieldByName(m, "type_url").String())
+	if err != nil {
+		return false
+	}
+
+	payload := mt.New().Interface()
+	if err := proto.Unmarshal(protoFieldByName(m, "value").Bytes(), payload); err != nil {
+		return false
+	}
+
+	renderProtoPayload(r, v, payload.ProtoReflect())
+
+	return true
+}
+
+// renderProtoPayload renders m, which is the payload of v, prefixed with the
+// full name of m's message type.
+//
+// As with Go type names, the payload is enclosed in parentheses unless it is
+// rendered as a block, which is not the case for some well-known types, such
+// as google.protobuf.Timestamp.
+func renderProtoPayload(r Renderer, v Value, m protoreflect.Message) {
+	s := r.FormatValue(protoGoValue(v, m.Interface()))
+
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+		r.Print("(")
+		defer r.Print(")")
+	}
+
+	if strings.HasPrefix(s, "{") {
+		r.Print("%s%s", m.Descriptor().FullName(), s)
+	} else {
+		r.Print("%s(%s)", m.Descriptor().FullName(), s)
+	}
+}
+
+// protoFieldByName returns the value of the field with the given name.
+func protoFieldByName(m protoreflect.Message, name protoreflect.Name) protoreflect.Value {
+	return m.Get(m.Descriptor().Fields().ByName(name))
+}
+
+// protoStructToJSON converts a google.protobuf.Struct, Value or ListValue
+// message to a tree of JSON nodes, as per [parseJSON].
+func protoStructToJSON(m protoreflect.Message) any {
+	switch m.Descriptor().Name() {
+	case "Struct":
+		fields := protoFieldByName(m, "fields").Map()
+		members := []jsonMember{}
+
+		fields.Range(
+			func(k protoreflect.MapKey, x protoreflect.Value) bool {
+				members = append(
+					members,
+					jsonMember{k.String(), protoStructToJSON(x.Message())},
+				)
+				return true
+			},
+		)
+
+		sort.Slice(
+			members,
+			func(i, j int) bool {
+				return members[i].Key < members[j].Key
+			},
+		)
+
+		return members
+
+	case "ListValue":
+		values := protoFieldByName(m, "values").List()
+		elements := make([]any, values.Len())
+
+		for i := range elements {
+			elements[i] = protoStructToJSON(values.Get(i).Message())
+		}
+
+		return elements
+	}
+
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("kind"))
+	if fd == nil {
+		return nil
+	}
+
+	x := m.Get(fd)
+
+	switch fd.Name() {
+	case "number_value":
+		return json.Number(strconv.FormatFloat(x.Float(), 'g', -1, 64))
+	case "string_value":
+		return x.String()
+	case "bool_value":
+		return x.Bool()
+	case "struct_value", "list_value":
+		return protoStructToJSON(x.Message())
+	default: // null_value
+		return nil
+	}
+}
@@ -0,0 +1,257 @@
+package dapper_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+	"github.com/dogmatiq/dapper/internal/fixtures"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type protoWellKnownTypes struct {
+	Timestamp *timestamppb.Timestamp
+	Duration  *durationpb.Duration
+	String    *wrapperspb.StringValue
+	Mask      *fieldmaskpb.FieldMask
+}
+
+func TestPrinter_ProtoFilter_wellKnownTypes(t *testing.T) {
+	tm := time.Date(2019, time.November, 3, 10, 13, 8, 839511000, time.UTC)
+
+	test(
+		t,
+		"timestamppb.Timestamp",
+		timestamppb.New(tm),
+		"*google.golang.org/protobuf/types/known/timestamppb.Timestamp(2019-11-03T10:13:08.839511Z)",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithTimeLayout(time.DateTime)),
+		"timestamppb.Timestamp (custom layout)",
+		timestamppb.New(tm),
+		"*google.golang.org/protobuf/types/known/timestamppb.Timestamp(2019-11-03 10:13:08)",
+	)
+
+	test(
+		t,
+		"durationpb.Duration",
+		durationpb.New(90*time.Second+5*time.Millisecond),
+		"*google.golang.org/protobuf/types/known/durationpb.Duration(1m30.005s)",
+	)
+
+	test(t, "wrapperspb.StringValue", wrapperspb.String("foo"), `*google.golang.org/protobuf/types/known/wrapperspb.StringValue("foo")`)
+	test(t, "wrapperspb.Int64Value", wrapperspb.Int64(-42), `*google.golang.org/protobuf/types/known/wrapperspb.Int64Value(-42)`)
+	test(t, "wrapperspb.BoolValue", wrapperspb.Bool(false), `*google.golang.org/protobuf/types/known/wrapperspb.BoolValue(false)`)
+	test(t, "emptypb.Empty", &emptypb.Empty{}, `*google.golang.org/protobuf/types/known/emptypb.Empty{}`)
+
+	s, err := structpb.NewStruct(
+		map[string]any{
+			"name":  "dapper",
+			"count": 3,
+			"tags":  []any{"a", true, nil},
+			"inner": map[string]any{},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test(
+		t,
+		"structpb.Struct",
+		s,
+		`*google.golang.org/protobuf/types/known/structpb.Struct{`,
+		`    "count": 3`,
+		`    "inner": {}`,
+		`    "name":  "dapper"`,
+		`    "tags":  {`,
+		`        "a"`,
+		`        true`,
+		`        nil`,
+		`    }`,
+		`}`,
+	)
+
+	test(
+		t,
+		"structpb.Value",
+		structpb.NewNumberValue(1.5),
+		`*google.golang.org/protobuf/types/known/structpb.Value(1.5)`,
+	)
+
+	test(
+		t,
+		"fieldmaskpb.FieldMask",
+		&fieldmaskpb.FieldMask{Paths: []string{"a.b", "c"}},
+		`*google.golang.org/protobuf/types/known/fieldmaskpb.FieldMask{`,
+		`    "a.b"`,
+		`    "c"`,
+		`}`,
+	)
+
+	test(
+		t,
+		"non-ambiguous types",
+		protoWellKnownTypes{
+			Timestamp: timestamppb.New(tm),
+			Duration:  durationpb.New(time.Hour),
+			String:    wrapperspb.String("foo"),
+			Mask:      &fieldmaskpb.FieldMask{},
+		},
+		`github.com/dogmatiq/dapper_test.protoWellKnownTypes{`,
+		`    Timestamp: 2019-11-03T10:13:08.839511Z`,
+		`    Duration:  1h0m0s`,
+		`    String:    "foo"`,
+		`    Mask:      {}`,
+		`}`,
+	)
+}
+
+type protoAnyHolder struct {
+	Payload *anypb.Any
+}
+
+func TestPrinter_ProtoFilter_any(t *testing.T) {
+	payload, err := anypb.New(
+		&fixtures.Message{
+			Str:  "hello",
+			Enum: fixtures.Enum_BAR,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test(
+		t,
+		"resolved via the global registry",
+		payload,
+		`*google.golang.org/protobuf/types/known/anypb.Any(dogmatiq.dapper.fixtures.Message{`,
+		`    str:  "hello"`,
+		`    enum: BAR`,
+		`})`,
+	)
+
+	test(
+		t,
+		"non-ambiguous type",
+		protoAnyHolder{payload},
+		`github.com/dogmatiq/dapper_test.protoAnyHolder{`,
+		`    Payload: dogmatiq.dapper.fixtures.Message{`,
+		`        str:  "hello"`,
+		`        enum: BAR`,
+		`    }`,
+		`}`,
+	)
+
+	timestamp, err := anypb.New(
+		timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test(
+		t,
+		"well-known type rendered as a scalar",
+		timestamp,
+		`*google.golang.org/protobuf/types/known/anypb.Any(google.protobuf.Timestamp(2020-01-02T03:04:05Z))`,
+	)
+
+	wrapper, err := anypb.New(wrapperspb.String("hi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test(
+		t,
+		"well-known wrapper type",
+		protoAnyHolder{wrapper},
+		`github.com/dogmatiq/dapper_test.protoAnyHolder{`,
+		`    Payload: google.protobuf.StringValue("hi")`,
+		`}`,
+	)
+
+	unresolved := &anypb.Any{
+		TypeUrl: "type.googleapis.com/unknown.Type",
+		Value:   []byte{0x08, 0x01},
+	}
+
+	test(
+		t,
+		"unresolvable type URL",
+		unresolved,
+		`*google.golang.org/protobuf/types/known/anypb.Any{`,
+		`    type_url: "type.googleapis.com/unknown.Type"`,
+		`    value:    {`,
+		`        00000000  08 01                                             |..|`,
+		`    }`,
+		`}`,
+	)
+
+	invalid := &anypb.Any{
+		TypeUrl: payload.TypeUrl,
+		Value:   []byte{0xff},
+	}
+
+	test(
+		t,
+		"invalid payload",
+		invalid,
+		`*google.golang.org/protobuf/types/known/anypb.Any{`,
+		`    type_url: "type.googleapis.com/dogmatiq.dapper.fixtures.Message"`,
+		`    value:    {`,
+		`        00000000  ff                                                |.|`,
+		`    }`,
+		`}`,
+	)
+
+	types := &protoregistry.Types{}
+	if err := types.RegisterMessage(
+		dynamicpb.NewMessageType(
+			(&fixtures.Nested{}).ProtoReflect().Descriptor(),
+		),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	nested, err := anypb.New(&fixtures.Nested{NestedA: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPrinter(WithProtoTypeResolver(types))
+
+	testWithPrinter(
+		t,
+		p,
+		"resolved via a custom resolver",
+		nested,
+		`*google.golang.org/protobuf/types/known/anypb.Any(dogmatiq.dapper.fixtures.Nested{`,
+		`    nested_a: "foo"`,
+		`})`,
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"not resolved by a custom resolver",
+		payload,
+		`*google.golang.org/protobuf/types/known/anypb.Any{`,
+		`    type_url: "type.googleapis.com/dogmatiq.dapper.fixtures.Message"`,
+		`    value:    {`,
+		`        00000000  0a 05 68 65 6c 6c 6f 10  02                       |..hello..|`,
+		`    }`,
+		`}`,
+	)
+}
@@ -0,0 +1,147 @@
+package dapper
+
+import (
+	"log/slog"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SlogFilter is a [Filter] that formats values from the [log/slog] package.
+//
+// [slog.Level] values are rendered by name. [slog.Value] values are rendered
+// as the value they contain, and [slog.Attr] values as a key/value pair. The
+// attributes of an [slog.Record] are rendered in the order they were added.
+func SlogFilter(r Renderer, v Value) {
+	if l, ok := AsConcrete[slog.Level](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", l)
+	} else if x, ok := AsConcrete[slog.Value](v); ok {
+		if x.Kind() == slog.KindGroup {
+			if v.IsAmbiguousType() {
+				r.WriteType(v)
+			}
+			renderLogAttrs(r, v, x.Group())
+		} else {
+			writeWithTypeIfAmbiguous(r, v, logValue(v, x))
+		}
+	} else if a, ok := AsConcrete[slog.Attr](v); ok {
+		if v.IsAmbiguousType() {
+			r.WriteType(v)
+			r.Print("(")
+		}
+
+		r.Print("%s: ", strconv.Quote(a.Key))
+		renderLogValue(r, v, a.Value)
+
+		if v.IsAmbiguousType() {
+			r.Print(")")
+		}
+	} else if rec, ok := AsConcrete[slog.Record](v); ok {
+		renderLogRecord(r, v, rec)
+	}
+}
+
+func renderLogRecord(r Renderer, v Value, rec slog.Record) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	var attrs []slog.Attr
+	rec.Attrs(
+		func(a slog.Attr) bool {
+			attrs = append(attrs, a)
+			return true
+		},
+	)
+
+	r.Print("{\n")
+	r.Indent()
+
+	r.Print("Time:    ")
+	r.WriteValue(logValue(v, slog.TimeValue(rec.Time)))
+	r.Print("\nLevel:   %s\n", rec.Level)
+	r.Print("Message: %s\n", strconv.Quote(rec.Message))
+	r.Print("Attrs:   ")
+	renderLogAttrs(r, v, attrs)
+	r.Print("\n")
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// renderLogAttrs renders a list of attributes as a map of keys to values, in
+// the order they appear in the list.
+func renderLogAttrs(r Renderer, v Value, attrs []slog.Attr) {
+	if len(attrs) == 0 {
+		r.Print("{}")
+		return
+	}
+
+	n := elementLimit(r, len(attrs))
+	more := len(attrs) - n
+	attrs = attrs[:n]
+
+	keys := make([]string, len(attrs))
+	alignment := 0
+
+	for i, a := range attrs {
+		keys[i] = strconv.Quote(a.Key)
+		if len(keys[i]) > alignment {
+			alignment = len(keys[i])
+		}
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for i, a := range attrs {
+		r.Print("%s: %s", keys[i], strings.Repeat(" ", alignment-len(keys[i])))
+		renderLogValue(r, v, a.Value)
+		r.Print("\n")
+	}
+
+	renderMoreElements(r, more)
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// renderLogValue renders the value contained within x without its type, unless
+// the contained value is of an arbitrary type.
+func renderLogValue(r Renderer, v Value, x slog.Value) {
+	if x.Kind() == slog.KindGroup {
+		renderLogAttrs(r, v, x.Group())
+	} else {
+		r.WriteValue(logValue(v, x))
+	}
+}
+
+// logValue returns a [Value] for the Go value contained within x, which is a
+// part of v.
+//
+// The type of the contained value is ambiguous if x holds an arbitrary value
+// or an [slog.LogValuer].
+func logValue(v Value, x slog.Value) Value {
+	rv := reflect.ValueOf(x.Any())
+
+	if !rv.IsValid() {
+		return Value{
+			Value:                  rv,
+			StaticType:             typeOf[any](),
+			IsAmbiguousDynamicType: true,
+			IsAmbiguousStaticType:  true,
+			IsUnexported:           v.IsUnexported,
+		}
+	}
+
+	ambiguous := x.Kind() == slog.KindAny || x.Kind() == slog.KindLogValuer
+
+	return Value{
+		Value:                  rv,
+		DynamicType:            rv.Type(),
+		StaticType:             rv.Type(),
+		IsAmbiguousDynamicType: ambiguous,
+		IsAmbiguousStaticType:  ambiguous,
+		IsUnexported:           v.IsUnexported,
+	}
+}
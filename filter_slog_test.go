@@ -0,0 +1,75 @@
+package dapper_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type slogPoint struct {
+	X, Y int
+}
+
+func TestPrinter_SlogFilter(t *testing.T) {
+	test(t, "slog.Level", slog.LevelWarn, "log/slog.Level(WARN)")
+	test(t, "slog.Level (offset)", slog.LevelInfo+2, "log/slog.Level(INFO+2)")
+	test(t, "slog.Value (string)", slog.StringValue("foo"), `log/slog.Value("foo")`)
+	test(t, "slog.Value (int)", slog.IntValue(42), `log/slog.Value(42)`)
+	test(t, "slog.Value (duration)", slog.DurationValue(time.Second), `log/slog.Value(1s)`)
+	test(t, "slog.Value (nil)", slog.AnyValue(nil), `log/slog.Value(any(nil))`)
+
+	test(
+		t,
+		"slog.Value (any)",
+		slog.AnyValue(slogPoint{1, 2}),
+		`log/slog.Value(github.com/dogmatiq/dapper_test.slogPoint{`,
+		`    X: 1`,
+		`    Y: 2`,
+		`})`,
+	)
+
+	test(
+		t,
+		"slog.Value (group)",
+		slog.GroupValue(slog.Int("count", 1), slog.String("name", "foo")),
+		`log/slog.Value{`,
+		`    "count": 1`,
+		`    "name":  "foo"`,
+		`}`,
+	)
+
+	test(t, "slog.Attr", slog.Int("count", 1), `log/slog.Attr("count": 1)`)
+
+	rec := slog.NewRecord(
+		time.Date(2019, time.November, 3, 10, 13, 8, 0, time.UTC),
+		slog.LevelInfo,
+		"hello",
+		0,
+	)
+	rec.AddAttrs(
+		slog.String("user", "bob"),
+		slog.Any("point", slogPoint{1, 2}),
+		slog.Group("request", slog.String("method", "GET")),
+	)
+
+	test(
+		t,
+		"slog.Record",
+		rec,
+		`log/slog.Record{`,
+		`    Time:    2019-11-03T10:13:08Z`,
+		`    Level:   INFO`,
+		`    Message: "hello"`,
+		`    Attrs:   {`,
+		`        "user":    "bob"`,
+		`        "point":   github.com/dogmatiq/dapper_test.slogPoint{`,
+		`            X: 1`,
+		`            Y: 2`,
+		`        }`,
+		`        "request": {`,
+		`            "method": "GET"`,
+		`        }`,
+		`    }`,
+		`}`,
+	)
+}
@@ -0,0 +1,111 @@
+package dapper
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// nullMarker is the string to display for SQL values that are NULL.
+const nullMarker = "NULL"
+
+// SQLFilter is a [Filter] that formats the nullable types from the
+// [database/sql] package, such as [sql.NullString] and [sql.Null], as either
+// NULL or their inner value.
+//
+// If [Config.RenderDriverValues] is true, any other implementation of
+// [driver.Valuer] is rendered as the result of its Value() method.
+func SQLFilter(r Renderer, v Value) {
+	if isSQLNullType(v.DynamicType) {
+		renderSQLNull(r, v)
+	} else if configOf(r).RenderDriverValues {
+		if valuer, ok := AsImplementationOf[driver.Valuer](v); ok {
+			if v.DynamicType.Kind() != reflect.Ptr || !v.Value.IsNil() {
+				renderDriverValue(r, v, valuer)
+			}
+		}
+	}
+}
+
+// isSQLNullType returns true if t is one of the nullable types from the
+// [database/sql] package.
+//
+// All such types are structs with a value field followed by a boolean field
+// named "Valid".
+func isSQLNullType(t reflect.Type) bool {
+	if t.PkgPath() != "database/sql" || t.Kind() != reflect.Struct {
+		return false
+	}
+
+	if t.NumField() != 2 {
+		return false
+	}
+
+	f := t.Field(1)
+	return f.Name == "Valid" && f.Type.Kind() == reflect.Bool
+}
+
+func renderSQLNull(r Renderer, v Value) {
+	if !v.Value.Field(1).Bool() {
+		printWithTypeIfAmbiguous(r, v, "%s", nullMarker)
+		return
+	}
+
+	f := v.DynamicType.Field(0)
+	fv := v.Value.Field(0)
+
+	writeWithTypeIfAmbiguous(
+		r,
+		v,
+		Value{
+			Value:                  fv,
+			DynamicType:            fv.Type(),
+			StaticType:             f.Type,
+			IsAmbiguousDynamicType: f.Type.Kind() == reflect.Interface,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           v.IsUnexported,
+		},
+	)
+}
+
+func renderDriverValue(r Renderer, v Value, valuer driver.Valuer) {
+	x, err := valuer.Value()
+	if err != nil {
+		printWithTypeIfAmbiguous(r, v, "<error: %s>", err)
+		return
+	}
+
+	if x == nil {
+		printWithTypeIfAmbiguous(r, v, "%s", nullMarker)
+		return
+	}
+
+	xv := reflect.ValueOf(x)
+
+	// Avoid infinite recursion if the valuer returns a value of its own type.
+	if xv.Type() == v.DynamicType {
+		printWithTypeIfAmbiguous(r, v, "%v", x)
+		return
+	}
+
+	// The result is not rendered as a driver value itself, otherwise valuers
+	// that return each other would recurse forever. A valid result is one of
+	// the basic types listed by [driver.Value] in any case.
+	r = r.WithModifiedConfig(
+		func(c *Config) {
+			c.RenderDriverValues = false
+		},
+	)
+
+	writeWithTypeIfAmbiguous(
+		r,
+		v,
+		Value{
+			Value:                  xv,
+			DynamicType:            xv.Type(),
+			StaticType:             xv.Type(),
+			IsAmbiguousDynamicType: !driver.IsValue(x),
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           v.IsUnexported,
+		},
+	)
+}
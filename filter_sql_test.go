@@ -0,0 +1,110 @@
+package dapper_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+type sqlMoney int64
+
+func (m sqlMoney) Value() (driver.Value, error) {
+	if m < 0 {
+		return nil, errors.New("negative amount")
+	}
+	return int64(m) * 100, nil
+}
+
+type sqlOptional struct {
+	Present bool
+}
+
+func (o sqlOptional) Value() (driver.Value, error) {
+	if !o.Present {
+		return nil, nil
+	}
+	return "present", nil
+}
+
+// sqlPing and sqlPong are valuers that return each other.
+type (
+	sqlPing struct{}
+	sqlPong struct{}
+)
+
+func (sqlPing) Value() (driver.Value, error) { return sqlPong{}, nil }
+func (sqlPong) Value() (driver.Value, error) { return sqlPing{}, nil }
+
+func TestPrinter_SQLFilter(t *testing.T) {
+	test(t, "sql.NullString (null)", sql.NullString{}, "database/sql.NullString(NULL)")
+	test(t, "sql.NullString (valid)", sql.NullString{String: "foo", Valid: true}, `database/sql.NullString("foo")`)
+	test(t, "sql.NullInt64", sql.NullInt64{Int64: 42, Valid: true}, "database/sql.NullInt64(42)")
+	test(t, "sql.Null[int]", sql.Null[int]{V: 42, Valid: true}, "database/sql.Null[int](42)")
+	test(t, "sql.Null[int] (null)", sql.Null[int]{}, "database/sql.Null[int](NULL)")
+	test(t, "*sql.NullBool", &sql.NullBool{Bool: true, Valid: true}, "*database/sql.NullBool(true)")
+
+	type row struct {
+		Name    sql.NullString
+		Age     sql.NullInt32
+		Created sql.NullTime
+	}
+
+	test(
+		t,
+		"non-ambiguous types",
+		row{
+			Name:    sql.NullString{String: "Jane", Valid: true},
+			Created: sql.NullTime{Time: time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC), Valid: true},
+		},
+		"github.com/dogmatiq/dapper_test.row{",
+		`    Name:    "Jane"`,
+		"    Age:     NULL",
+		"    Created: 2001-02-03T04:05:06Z",
+		"}",
+	)
+
+	test(
+		t,
+		"driver values are not rendered by default",
+		sqlMoney(5),
+		"github.com/dogmatiq/dapper_test.sqlMoney(5)",
+	)
+
+	p := NewPrinter(WithDriverValues(true))
+
+	testWithPrinter(t, p, "driver.Valuer", sqlMoney(5), "github.com/dogmatiq/dapper_test.sqlMoney(500)")
+	testWithPrinter(t, p, "driver.Valuer (error)", sqlMoney(-1), "github.com/dogmatiq/dapper_test.sqlMoney(<error: negative amount>)")
+	testWithPrinter(t, p, "driver.Valuer (null)", sqlOptional{}, "github.com/dogmatiq/dapper_test.sqlOptional(NULL)")
+	testWithPrinter(t, p, "driver.Valuer (string)", sqlOptional{true}, `github.com/dogmatiq/dapper_test.sqlOptional("present")`)
+	testWithPrinter(t, p, "driver.Valuer (mutually recursive)", sqlPing{}, "github.com/dogmatiq/dapper_test.sqlPing(github.com/dogmatiq/dapper_test.sqlPong{})")
+}
+
+func TestPrinter_SQLFilter_maxDepth(t *testing.T) {
+	type inner struct {
+		X atomicPoint
+	}
+
+	type outer struct {
+		A sql.Null[inner]
+		B inner
+	}
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxDepth(2)),
+		"the nullable value does not count towards the depth",
+		outer{A: sql.Null[inner]{V: inner{X: atomicPoint{1, 2}}, Valid: true}, B: inner{X: atomicPoint{1, 2}}},
+		"github.com/dogmatiq/dapper_test.outer{",
+		"    A: {",
+		"        X: {...}",
+		"    }",
+		"    B: {",
+		"        X: {...}",
+		"    }",
+		"}",
+	)
+}
@@ -0,0 +1,200 @@
+package dapper
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+
+	"github.com/dogmatiq/dapper/internal/unsafereflect"
+)
+
+// StackTraceFilter is a [Filter] that formats stack traces, represented as
+// [runtime.Frame], [runtime.Frames] or []uintptr program counters, as a list
+// of function names and source locations.
+//
+// A []uintptr is only considered a stack trace if
+// [Config.RenderProgramCounters] is enabled and every element is the program
+// counter of a known function.
+func StackTraceFilter(r Renderer, v Value) {
+	if f, ok := AsConcrete[runtime.Frame](v); ok {
+		printWithTypeIfAmbiguous(r, v, "%s", formatFrame(r, f))
+	} else if Is[*runtime.Frames](v) {
+		if !v.Value.IsNil() {
+			if frames, ok := pendingFrames(v.Value.Elem()); ok {
+				renderStackTrace(r, v, frames)
+			}
+		}
+	} else if Is[runtime.Frames](v) {
+		if frames, ok := pendingFrames(v.Value); ok {
+			renderStackTrace(r, v, frames)
+		}
+	} else if pcs, ok := AsConcrete[[]uintptr](v); ok {
+		if configOf(r).RenderProgramCounters && isProgramCounters(pcs) {
+			renderStackTrace(r, v, callersFrames(pcs))
+		}
+	}
+}
+
+// renderStackTrace renders a list of stack frames.
+func renderStackTrace(r Renderer, v Value, frames []runtime.Frame) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	if len(frames) == 0 {
+		r.Print("{}")
+		return
+	}
+
+	r.Print("{\n")
+	r.Indent()
+
+	for _, line := range formatFrames(r, "", frames) {
+		r.Print("%s\n", line)
+	}
+
+	r.Outdent()
+	r.Print("}")
+}
+
+// formatFrames returns a "function (file:line)" representation of each frame,
+// preceded by prefix.
+//
+// If the number of frames exceeds the limit set by [Config.MaxStackFrames] the
+// remaining frames are summarized by a single line.
+func formatFrames(r Renderer, prefix string, frames []runtime.Frame) []string {
+	var lines []string
+
+	for i, f := range frames {
+		if limit := configOf(r).MaxStackFrames; limit > 0 && i == limit {
+			lines = append(lines, fmt.Sprintf("<%d more frame(s)>", len(frames)-i))
+			break
+		}
+
+		lines = append(lines, prefix+formatFrame(r, f))
+	}
+
+	return lines
+}
+
+// formatFrame returns a "function (file:line)" representation of f.
+func formatFrame(r Renderer, f runtime.Frame) string {
+	name := f.Function
+	if name == "" {
+		name = formatPointer(r, "pc", f.PC, false)
+	} else {
+		name = formatFuncName(configOf(r), name)
+	}
+
+	if f.File == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s (%s:%d)", name, f.File, f.Line)
+}
+
+// callersFrames returns the frames for the program counters in pcs, as
+// returned by [runtime.Callers].
+func callersFrames(pcs []uintptr) []runtime.Frame {
+	var frames []runtime.Frame
+
+	iter := runtime.CallersFrames(pcs)
+	for {
+		f, more := iter.Next()
+		if f.PC != 0 {
+			frames = append(frames, f)
+		}
+		if !more {
+			return frames
+		}
+	}
+}
+
+// pendingFrames returns the frames that have not yet been consumed from a
+// [runtime.Frames] value, without modifying it.
+func pendingFrames(v reflect.Value) ([]runtime.Frame, bool) {
+	// Calling [runtime.Frames.Next] would consume the frames, so instead we
+	// build a new iterator from the unexpanded program counters, in the same
+	// defensive manner used to extract mutex state.
+	callers := v.FieldByName("callers")
+	expanded := v.FieldByName("frames")
+
+	if !callers.IsValid() || !expanded.IsValid() {
+		return nil, false
+	}
+
+	pcs, ok := unsafereflect.MakeMutable(callers).Interface().([]uintptr)
+	if !ok {
+		return nil, false
+	}
+
+	frames, ok := unsafereflect.MakeMutable(expanded).Interface().([]runtime.Frame)
+	if !ok {
+		return nil, false
+	}
+
+	if next := v.FieldByName("nextPC"); next.IsValid() && next.Kind() == reflect.Uintptr {
+		if pc := uintptr(next.Uint()); pc != 0 {
+			pcs = append([]uintptr{pc}, pcs...)
+		}
+	}
+
+	return append(
+		append([]runtime.Frame(nil), frames...),
+		callersFrames(pcs)...,
+	), true
+}
+
+// isProgramCounters returns true if pcs is non-empty and every element is the
+// program counter of a known function.
+//
+// Zero elements, such as the unused portion of a buffer passed to
+// [runtime.Callers], are not program counters, and are not permitted so that
+// no data is omitted from the output.
+func isProgramCounters(pcs []uintptr) bool {
+	if len(pcs) == 0 {
+		return false
+	}
+
+	for _, pc := range pcs {
+		if pc == 0 || runtime.FuncForPC(pc) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// stackTraceOf returns the stack trace attached to e, if any.
+//
+// The stack trace is obtained by calling a StackTrace() method that takes no
+// arguments and returns a single value of type []runtime.Frame,
+// *runtime.Frames or a slice of program counters, such as []uintptr.
+func stackTraceOf(e error) ([]runtime.Frame, bool) {
+	m := reflect.ValueOf(e).MethodByName("StackTrace")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil, false
+	}
+
+	t := m.Type().Out(0)
+
+	switch {
+	case t == typeOf[[]runtime.Frame]():
+		return m.Call(nil)[0].Interface().([]runtime.Frame), true
+	case t == typeOf[*runtime.Frames]():
+		f := m.Call(nil)[0]
+		if f.IsNil() {
+			return nil, false
+		}
+		return pendingFrames(f.Elem())
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uintptr:
+		s := m.Call(nil)[0]
+		pcs := make([]uintptr, s.Len())
+		for i := range pcs {
+			pcs[i] = uintptr(s.Index(i).Uint())
+		}
+		return callersFrames(pcs), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,176 @@
+package dapper_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+// captureStack returns a stack trace containing a single frame, and the
+// "function (file:line)" representation of that frame.
+func captureStack() ([]uintptr, string) {
+	pcs := make([]uintptr, 1)
+	_, file, line, _ := runtime.Caller(0)
+	runtime.Callers(1, pcs)
+
+	return pcs, fmt.Sprintf(
+		"github.com/dogmatiq/dapper_test.captureStack (%s:%d)",
+		file,
+		line+1,
+	)
+}
+
+type stackTraceError struct {
+	pcs []uintptr
+}
+
+func (e stackTraceError) Error() string         { return "<error>" }
+func (e stackTraceError) StackTrace() []uintptr { return e.pcs }
+
+func TestPrinter_StackTraceFilter(t *testing.T) {
+	pcs, frame := captureStack()
+
+	test(
+		t,
+		"[]uintptr",
+		pcs,
+		"[]uintptr{",
+		fmt.Sprintf("    0x%x", pcs[0]),
+		"}",
+	)
+
+	p := NewPrinter(WithProgramCounters(true))
+
+	testWithPrinter(
+		t,
+		p,
+		"[]uintptr (program counters enabled)",
+		pcs,
+		"[]uintptr{",
+		"    "+frame,
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"[]uintptr that does not contain program counters",
+		[]uintptr{1, 2},
+		"[]uintptr{",
+		"    0x1",
+		"    0x2",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"[]uintptr that contains only zeros",
+		[]uintptr{0, 0},
+		"[]uintptr{",
+		"    0",
+		"    0",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"[]uintptr that contains program counters and zeros",
+		[]uintptr{pcs[0], 0},
+		"[]uintptr{",
+		fmt.Sprintf("    0x%x", pcs[0]),
+		"    0",
+		"}",
+	)
+
+	f, _ := runtime.CallersFrames(pcs).Next()
+
+	test(
+		t,
+		"runtime.Frame",
+		f,
+		"runtime.Frame("+frame+")",
+	)
+
+	frames := runtime.CallersFrames(pcs)
+
+	test(
+		t,
+		"*runtime.Frames",
+		frames,
+		"*runtime.Frames{",
+		"    "+frame,
+		"}",
+	)
+
+	if f, _ := frames.Next(); f.PC == 0 {
+		t.Fatal("rendering consumed the frames")
+	}
+
+	test(
+		t,
+		"*runtime.Frames (consumed)",
+		frames,
+		"*runtime.Frames{}",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithPackagePaths(false)),
+		"without package paths",
+		f,
+		"runtime.Frame(dapper_test.captureStack"+frame[len("github.com/dogmatiq/dapper_test.captureStack"):]+")",
+	)
+}
+
+func TestPrinter_ErrorFilter_StackTrace(t *testing.T) {
+	pcs, frame := captureStack()
+	err := stackTraceError{append(pcs, pcs...)}
+	pc := fmt.Sprintf("        0x%x", pcs[0])
+
+	test(
+		t,
+		"error with stack trace",
+		err,
+		"github.com/dogmatiq/dapper_test.stackTraceError{",
+		"    pcs: {",
+		pc,
+		pc,
+		"    }",
+		"} [<error>]",
+		"    at "+frame,
+		"    at "+frame,
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxStackFrames(1)),
+		"limited number of frames",
+		struct{ Err error }{err},
+		"{",
+		"    Err: github.com/dogmatiq/dapper_test.stackTraceError{",
+		"        pcs: {",
+		"    "+pc,
+		"    "+pc,
+		"        }",
+		"    } [<error>]",
+		"        at "+frame,
+		"        <1 more frame(s)>",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithErrorTrees(true)),
+		"error tree with stack trace",
+		fmt.Errorf("<outer>: %w", stackTraceError{pcs}),
+		"*fmt.wrapError [<outer>] {",
+		"    github.com/dogmatiq/dapper_test.stackTraceError [<error>] {",
+		"        at "+frame,
+		"    }",
+		"}",
+	)
+}
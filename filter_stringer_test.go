@@ -2,6 +2,8 @@ package dapper_test
 
 import (
 	"testing"
+
+	. "github.com/dogmatiq/dapper"
 )
 
 type stringer string
@@ -41,3 +43,23 @@ func TestPrinter_StringerFilter(t *testing.T) {
 		"}",
 	)
 }
+
+func TestPrinter_StringerFilter_maxDepth(t *testing.T) {
+	type outer struct {
+		C stringer
+	}
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxDepth(2)),
+		"the string is not truncated",
+		[]outer{{C: "func main() {\n    body()\n}"}},
+		"[]github.com/dogmatiq/dapper_test.outer{",
+		"    {",
+		"        C: [func main() {",
+		"            body()",
+		"        }]",
+		"    }",
+		"}",
+	)
+}
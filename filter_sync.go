@@ -12,5 +12,11 @@ func SyncFilter(r Renderer, v Value) {
 		renderSyncOnce(r, v)
 	} else if Is[sync.Map](v) {
 		renderSyncMap(r, v)
+	} else if Is[sync.WaitGroup](v) {
+		renderWaitGroup(r, v)
+	} else if Is[sync.Cond](v) {
+		renderCond(r, v)
+	} else if Is[sync.Pool](v) {
+		renderPool(r, v)
 	}
 }
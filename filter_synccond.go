@@ -0,0 +1,60 @@
+package dapper
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+func renderCond(r Renderer, v Value) {
+	desc := "<unknown state>"
+
+	if waiters, ok := extractCondWaiters(v.Value); ok {
+		desc = fmt.Sprintf("<waiters: %d>", waiters)
+	}
+
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+	}
+
+	l := v.Value.FieldByName("L")
+
+	r.Print("{\n")
+	r.Indent()
+	r.Print("L: ")
+	r.WriteValue(
+		Value{
+			Value:                  l,
+			DynamicType:            l.Type(),
+			StaticType:             typeOf[sync.Locker](),
+			IsAmbiguousDynamicType: true,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           v.IsUnexported,
+		},
+	)
+	r.Print("\n")
+	r.Outdent()
+	r.Print("} %s", desc)
+}
+
+func extractCondWaiters(v reflect.Value) (uint32, bool) {
+	n := v.FieldByName("notify")
+	if !n.IsValid() {
+		return 0, false
+	}
+
+	// The "wait" field is the ticket number of the next goroutine to wait, and
+	// "notify" is the ticket number of the next goroutine to be notified. Both
+	// may wrap around, so the difference is computed using unsigned overflow.
+	wait, ok := asUint(n.FieldByName("wait"))
+	if !ok {
+		return 0, false
+	}
+
+	notify, ok := asUint(n.FieldByName("notify"))
+	if !ok {
+		return 0, false
+	}
+
+	return uint32(wait) - uint32(notify), true
+}
@@ -0,0 +1,85 @@
+package dapper_test
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+// nopLocker is a [sync.Locker] that does nothing.
+type nopLocker struct{}
+
+func (nopLocker) Lock()   {}
+func (nopLocker) Unlock() {}
+
+func TestPrinter_SyncFilter_Cond(t *testing.T) {
+	var m sync.Mutex
+	c := sync.NewCond(&m)
+
+	test(
+		t,
+		"sync.Cond (no waiters)",
+		c,
+		"*sync.Cond{",
+		"    L: *sync.Mutex(<unlocked>)",
+		"} <waiters: 0>",
+	)
+
+	// The waiter's locker has no state, so that it can be rendered while the
+	// waiter is locking and unlocking it.
+	w := sync.NewCond(nopLocker{})
+
+	done := make(chan struct{})
+	go func() {
+		w.L.Lock()
+		w.Wait()
+		w.L.Unlock()
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.HasSuffix(Format(w), "<waiters: 1>") {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the waiter, last output:\n%s", Format(w))
+		}
+		runtime.Gosched()
+	}
+
+	test(
+		t,
+		"sync.Cond (waiters)",
+		w,
+		"*sync.Cond{",
+		"    L: github.com/dogmatiq/dapper_test.nopLocker{}",
+		"} <waiters: 1>",
+	)
+
+	w.Signal()
+	<-done
+
+	test(
+		t,
+		"sync.Cond (signalled)",
+		w,
+		"*sync.Cond{",
+		"    L: github.com/dogmatiq/dapper_test.nopLocker{}",
+		"} <waiters: 0>",
+	)
+
+	test(
+		t,
+		"sync.Cond (unexported struct field)",
+		struct {
+			c sync.Cond
+		}{},
+		"{",
+		"    c: sync.Cond{",
+		"        L: nil",
+		"    } <waiters: 0>",
+		"}",
+	)
+}
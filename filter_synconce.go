@@ -1,11 +1,13 @@
 package dapper
 
+import "reflect"
+
 func renderSyncOnce(r Renderer, v Value) {
 	done := v.Value.FieldByName("done")
 
 	desc := "<unknown state>"
-	if done, ok := asUint(done); ok {
-		if done != 0 {
+	if done, ok := asOnceDone(done); ok {
+		if done {
 			desc = "<complete>"
 		} else {
 			desc = "<pending>"
@@ -19,3 +21,14 @@ func renderSyncOnce(r Renderer, v Value) {
 		desc,
 	)
 }
+
+// asOnceDone returns the value of the "done" field of a [sync.Once].
+func asOnceDone(v reflect.Value) (bool, bool) {
+	// At some point, the "done" field was changed from a uint32 to an
+	// [atomic.Bool].
+	if done, ok := asUint(v); ok {
+		return done != 0, true
+	}
+
+	return asBool(v)
+}
@@ -0,0 +1,14 @@
+package dapper
+
+// renderPool renders a [sync.Pool].
+//
+// The contents of a pool are distributed across per-processor caches that are
+// cleared by the garbage collector, so they are not rendered.
+func renderPool(r Renderer, v Value) {
+	printWithTypeIfAmbiguous(
+		r,
+		v,
+		"%s",
+		"<opaque>",
+	)
+}
@@ -0,0 +1,31 @@
+package dapper_test
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPrinter_SyncFilter_Pool(t *testing.T) {
+	p := &sync.Pool{
+		New: func() any { return 1 },
+	}
+	p.Put(2)
+
+	test(
+		t,
+		"sync.Pool",
+		p,
+		"*sync.Pool(<opaque>)",
+	)
+
+	test(
+		t,
+		"sync.Pool (unexported struct field)",
+		struct {
+			p *sync.Pool
+		}{p},
+		"{",
+		"    p: *sync.Pool(<opaque>)",
+		"}",
+	)
+}
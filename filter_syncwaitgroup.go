@@ -0,0 +1,35 @@
+package dapper
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func renderWaitGroup(r Renderer, v Value) {
+	desc := "<unknown state>"
+
+	if counter, waiters, ok := extractWaitGroupState(v.Value); ok {
+		desc = fmt.Sprintf("<counter: %d, waiters: %d>", counter, waiters)
+	}
+
+	printWithTypeIfAmbiguous(
+		r,
+		v,
+		"%s",
+		desc,
+	)
+}
+
+func extractWaitGroupState(v reflect.Value) (counter int32, waiters uint32, ok bool) {
+	state, ok := asUint(v.FieldByName("state"))
+	if !ok {
+		return 0, 0, false
+	}
+
+	// The counter is stored in the high 32 bits of the state. The low 32 bits
+	// store the waiter count, the high bit of which was later repurposed as a
+	// flag for use by the testing/synctest package.
+	const waitersMask = 0x7fff_ffff
+
+	return int32(state >> 32), uint32(state) & waitersMask, true
+}
@@ -0,0 +1,65 @@
+package dapper_test
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+func TestPrinter_SyncFilter_WaitGroup(t *testing.T) {
+	var wg sync.WaitGroup
+
+	test(
+		t,
+		"sync.WaitGroup (zero)",
+		&wg, // use pointer to avoid copy
+		"*sync.WaitGroup(<counter: 0, waiters: 0>)",
+	)
+
+	wg.Add(3)
+	test(
+		t,
+		"sync.WaitGroup (non-zero counter)",
+		&wg, // use pointer to avoid copy
+		"*sync.WaitGroup(<counter: 3, waiters: 0>)",
+	)
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(Format(&wg), "waiters: 1") {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the waiter, last output:\n%s", Format(&wg))
+		}
+		runtime.Gosched()
+	}
+
+	test(
+		t,
+		"sync.WaitGroup (waiters)",
+		&wg, // use pointer to avoid copy
+		"*sync.WaitGroup(<counter: 3, waiters: 1>)",
+	)
+
+	wg.Add(-3)
+	<-done
+
+	test(
+		t,
+		"sync.WaitGroup (unexported struct field)",
+		struct {
+			wg sync.WaitGroup
+		}{},
+		"{",
+		"    wg: sync.WaitGroup(<counter: 0, waiters: 0>)",
+		"}",
+	)
+}
@@ -3,6 +3,7 @@ package dapper_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	. "github.com/dogmatiq/dapper"
@@ -53,4 +54,118 @@ func TestPrinter_WithFilter(t *testing.T) {
 			t.Fatal("actual:\n\n" + actual + "\n")
 		}
 	})
+
+	t.Run("its output is subject to the maximum depth", func(t *testing.T) {
+		type testType struct{}
+
+		p := NewPrinter(
+			WithMaxDepth(2),
+			WithFilter(
+				func(r Renderer, v Value) {
+					if v.DynamicType != reflect.TypeOf(testType{}) {
+						return
+					}
+
+					r.Print("testType{\n")
+					r.Indent()
+					r.Print("outer: {\n")
+					r.Indent()
+					r.Print("inner: {\n")
+					r.Indent()
+					r.Print("<content>\n")
+					r.Outdent()
+					r.Print("} <annotation>\n")
+					r.Outdent()
+					r.Print("}\n")
+					r.Outdent()
+					r.Print("}")
+				},
+			),
+		)
+
+		expected := strings.Join(
+			[]string{
+				"testType{",
+				"    outer: {",
+				"        inner: {...} <annotation>",
+				"    }",
+				"}",
+			},
+			"\n",
+		)
+		t.Log("expected:\n\n" + expected + "\n")
+
+		actual := p.Format(testType{})
+		if actual != expected {
+			t.Fatal("actual:\n\n" + actual + "\n")
+		}
+	})
+}
+
+type panickingError struct {
+	Message string
+}
+
+func (e *panickingError) Error() string { return e.Message }
+
+type panickingStringer struct {
+	Value string
+}
+
+func (s *panickingStringer) DapperString() string { return s.Value }
+
+func TestPrinter_FilterPanics(t *testing.T) {
+	test(
+		t,
+		"Error() method panics",
+		struct{ Err error }{(*panickingError)(nil)},
+		"{",
+		"    Err: *github.com/dogmatiq/dapper_test.panickingError(nil) <panic: runtime error: invalid memory address or nil pointer dereference>",
+		"}",
+	)
+
+	test(
+		t,
+		"DapperString() method panics",
+		(*panickingStringer)(nil),
+		"*github.com/dogmatiq/dapper_test.panickingStringer(nil) <panic: runtime error: invalid memory address or nil pointer dereference>",
+	)
+
+	p := NewPrinter(
+		WithFilter(
+			func(r Renderer, v Value) {
+				if v.DynamicType == reflect.TypeOf(0) {
+					r.Print("<partial output>")
+					panic("<filter panic>")
+				}
+			},
+		),
+		WithAnnotator(
+			func(v Value) string {
+				if v.DynamicType == reflect.TypeOf("") {
+					panic("<annotator panic>")
+				}
+				return ""
+			},
+		),
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"custom filter panics",
+		[]int{1, 2},
+		"[]int{",
+		"    1 <panic: <filter panic>>",
+		"    2 <panic: <filter panic>>",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"annotator panics",
+		"foo",
+		`"foo" <<panic: <annotator panic>>>`,
+	)
 }
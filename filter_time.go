@@ -1,14 +1,103 @@
 package dapper
 
 import (
+	"reflect"
+	"strings"
 	"time"
 )
 
 // TimeFilter is a filter that formats various values from the [time] package.
+//
+// [time.Time] values are rendered using [Config.TimeLayout], followed by their
+// monotonic clock reading, if [Config.RenderMonotonicClock] is enabled, and
+// their offset from [Config.ReferenceTime], if set.
 func TimeFilter(r Renderer, v Value) {
 	if t, ok := AsConcrete[time.Time](v); ok {
-		r.Print("%s", t.Format(time.RFC3339Nano))
+		r.Print("%s", formatTime(configOf(r), t))
 	} else if d, ok := AsConcrete[time.Duration](v); ok {
 		r.Print("%s", d)
+	} else if Is[time.Location](v) {
+		printWithTypeIfAmbiguous(r, v, "%s", locationOf(v.Value))
+	} else if m, ok := AsConcrete[time.Month](v); ok {
+		if m >= time.January && m <= time.December {
+			printWithTypeIfAmbiguous(r, v, "%s", m)
+		} else {
+			renderUnknownEnum(r, v)
+		}
+	} else if d, ok := AsConcrete[time.Weekday](v); ok {
+		if d >= time.Sunday && d <= time.Saturday {
+			printWithTypeIfAmbiguous(r, v, "%s", d)
+		} else {
+			renderUnknownEnum(r, v)
+		}
 	}
 }
+
+// formatTime returns the representation of t according to the configuration.
+func formatTime(c *Config, t time.Time) string {
+	layout := c.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+
+	var w strings.Builder
+
+	if c.RenderTimesInUTC {
+		w.WriteString(t.UTC().Format(layout))
+	} else {
+		w.WriteString(t.Format(layout))
+	}
+
+	// The monotonic clock reading is only exposed via the String() method, as
+	// a trailing "m=±<value>" component.
+	if c.RenderMonotonicClock {
+		if s := t.String(); strings.Contains(s, " m=") {
+			w.WriteString(" ")
+			w.WriteString(annotationPrefix)
+			w.WriteString(s[strings.LastIndex(s, " m=")+1:])
+			w.WriteString(annotationSuffix)
+		}
+	}
+
+	if !c.ReferenceTime.IsZero() {
+		w.WriteString(" ")
+		w.WriteString(annotationPrefix)
+		w.WriteString(formatRelativeDuration(t.Sub(c.ReferenceTime)))
+		w.WriteString(" from ref")
+		w.WriteString(annotationSuffix)
+	}
+
+	return w.String()
+}
+
+// formatRelativeDuration returns a signed representation of d, omitting any
+// trailing zero units.
+func formatRelativeDuration(d time.Duration) string {
+	s := d.String()
+
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	if d >= 0 {
+		return "+" + s
+	}
+
+	return s
+}
+
+// locationOf returns the name of the [time.Location] in v.
+func locationOf(v reflect.Value) string {
+	// Use the original location if possible, as the [time.Local] location is
+	// lazily initialized and a copy may not yet contain its name.
+	if v.CanAddr() {
+		return v.Addr().Interface().(*time.Location).String()
+	}
+
+	loc := v.Interface().(time.Location)
+	return loc.String()
+}
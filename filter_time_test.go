@@ -1,10 +1,19 @@
 package dapper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
+
+	. "github.com/dogmatiq/dapper"
 )
 
+type timeFields struct {
+	Loc *time.Location
+	M   time.Month
+	D   time.Weekday
+}
+
 func TestPrinter_TimeFilter(t *testing.T) {
 	tm := time.Date(
 		2019,
@@ -54,4 +63,65 @@ func TestPrinter_TimeFilter(t *testing.T) {
 		"    d: 20s",
 		"}",
 	)
+
+	test(t, "*time.Location", time.UTC, "*time.Location(UTC)")
+	test(t, "time.Month", time.March, "time.Month(March)")
+	test(t, "time.Month (unknown)", time.Month(13), "time.Month(13 <unknown>)")
+	test(t, "time.Weekday", time.Friday, "time.Weekday(Friday)")
+	test(t, "time.Weekday (unknown)", time.Weekday(7), "time.Weekday(7 <unknown>)")
+
+	test(
+		t,
+		"non-ambiguous types",
+		timeFields{time.UTC, time.March, time.Friday},
+		"github.com/dogmatiq/dapper_test.timeFields{",
+		"    Loc: UTC",
+		"    M:   March",
+		"    D:   Friday",
+		"}",
+	)
+}
+
+func TestPrinter_TimeFilter_options(t *testing.T) {
+	tm := time.Date(2019, time.November, 3, 10, 13, 8, 0, time.FixedZone("AEST", 10*60*60))
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithTimeLayout(time.DateTime)),
+		"custom layout",
+		tm,
+		"2019-11-03 10:13:08",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithUTCTimes(true)),
+		"UTC normalization",
+		tm,
+		"2019-11-03T00:13:08Z",
+	)
+
+	p := NewPrinter(WithReferenceTime(tm.Add(-3*time.Hour - 2*time.Minute)))
+
+	testWithPrinter(t, p, "after reference time", tm, "2019-11-03T10:13:08+10:00 <<+3h2m from ref>>")
+	testWithPrinter(t, p, "before reference time", tm.Add(-4*time.Hour), "2019-11-03T06:13:08+10:00 <<-58m from ref>>")
+}
+
+func TestPrinter_TimeFilter_monotonic(t *testing.T) {
+	tm := time.Now()
+
+	if s := Format(tm); strings.Contains(s, "m=") {
+		t.Fatalf("unexpected monotonic clock reading: %s", s)
+	}
+
+	p := NewPrinter(WithMonotonicClock(true))
+	s := p.Format(tm)
+
+	if !strings.HasSuffix(s, " <<m="+strings.SplitN(tm.String(), " m=", 2)[1]+">>") {
+		t.Fatalf("unexpected output: %s", s)
+	}
+
+	if s := p.Format(tm.Round(0)); strings.Contains(s, "m=") {
+		t.Fatalf("unexpected monotonic clock reading: %s", s)
+	}
 }
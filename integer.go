@@ -0,0 +1,144 @@
+package dapper
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// IntegerFormat controls how integer values are rendered.
+type IntegerFormat struct {
+	// Base is the numeric base used to render the integer. It must be one of 2,
+	// 8, 10 or 16. A zero value is equivalent to 10.
+	//
+	// Non-decimal integers are rendered with the same prefix used by Go's
+	// integer literals, that is "0b", "0o" or "0x".
+	Base int
+
+	// GroupDigits, when true, separates groups of digits with underscores, as
+	// per Go's integer literal syntax.
+	//
+	// Decimal integers are grouped into thousands. Integers in all other bases
+	// are grouped into blocks of 4 digits.
+	GroupDigits bool
+}
+
+// integer is a constraint that matches any integer type.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// WithIntegerFormat sets the format used to render integers of type T.
+//
+// It takes precedence over the format set by [WithDefaultIntegerFormat]. The
+// format applies only to T itself, not to other types that share the same
+// underlying type.
+func WithIntegerFormat[T integer](f IntegerFormat) Option {
+	f.mustBeValid()
+
+	return func(cfg *Config) {
+		if cfg.IntegerFormatByType == nil {
+			cfg.IntegerFormatByType = map[reflect.Type]IntegerFormat{}
+		}
+		cfg.IntegerFormatByType[typeOf[T]()] = f
+	}
+}
+
+// WithDefaultIntegerFormat sets the format used to render integers that do not
+// have a type-specific format.
+//
+// It does not apply to [uintptr] values, which are always rendered in
+// hexadecimal, consistent with other pointer-like values, unless overridden by
+// [WithIntegerFormat].
+func WithDefaultIntegerFormat(f IntegerFormat) Option {
+	f.mustBeValid()
+
+	return func(cfg *Config) {
+		cfg.IntegerFormat = f
+	}
+}
+
+// mustBeValid panics if f has an unsupported base.
+func (f IntegerFormat) mustBeValid() {
+	switch f.Base {
+	case 0, 2, 8, 10, 16:
+	default:
+		panic(fmt.Sprintf("unsupported integer base: %d", f.Base))
+	}
+}
+
+// integerFormat returns the format to use when rendering integers of type t.
+func integerFormat(c *Config, t reflect.Type) (IntegerFormat, bool) {
+	if f, ok := c.IntegerFormatByType[t]; ok {
+		return f, true
+	}
+
+	if t.Kind() == reflect.Uintptr {
+		return IntegerFormat{}, false
+	}
+
+	return c.IntegerFormat, true
+}
+
+// formatInt returns the string representation of n in the given format.
+func formatInt(n int64, f IntegerFormat) string {
+	if n < 0 {
+		return "-" + formatUint(-uint64(n), f)
+	}
+	return formatUint(uint64(n), f)
+}
+
+// formatUint returns the string representation of n in the given format.
+func formatUint(n uint64, f IntegerFormat) string {
+	base := f.Base
+	if base == 0 {
+		base = 10
+	}
+
+	digits := strconv.FormatUint(n, base)
+
+	if f.GroupDigits {
+		size := 4
+		if base == 10 {
+			size = 3
+		}
+		digits = groupDigits(digits, size)
+	}
+
+	switch base {
+	case 2:
+		return "0b" + digits
+	case 8:
+		return "0o" + digits
+	case 16:
+		return "0x" + digits
+	default:
+		return digits
+	}
+}
+
+// groupDigits separates s into groups of the given size, starting from the
+// least significant digit.
+func groupDigits(s string, size int) string {
+	if len(s) <= size {
+		return s
+	}
+
+	var w strings.Builder
+
+	head := len(s) % size
+	if head == 0 {
+		head = size
+	}
+
+	w.WriteString(s[:head])
+
+	for i := head; i < len(s); i += size {
+		w.WriteByte('_')
+		w.WriteString(s[i : i+size])
+	}
+
+	return w.String()
+}
@@ -0,0 +1,83 @@
+package dapper_test
+
+import (
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+func TestPrinter_WithDefaultIntegerFormat(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Format IntegerFormat
+		Value  any
+		Output string
+	}{
+		{"decimal", IntegerFormat{}, 1234567, "int(1234567)"},
+		{"decimal (grouped)", IntegerFormat{GroupDigits: true}, 1234567, "int(1_234_567)"},
+		{"decimal (grouped, negative)", IntegerFormat{GroupDigits: true}, -1234567, "int(-1_234_567)"},
+		{"decimal (grouped, exact multiple)", IntegerFormat{GroupDigits: true}, 123456, "int(123_456)"},
+		{"decimal (grouped, short)", IntegerFormat{GroupDigits: true}, 123, "int(123)"},
+		{"hexadecimal", IntegerFormat{Base: 16}, uint32(0xdeadbeef), "uint32(0xdeadbeef)"},
+		{"hexadecimal (grouped)", IntegerFormat{Base: 16, GroupDigits: true}, uint32(0xdeadbeef), "uint32(0xdead_beef)"},
+		{"hexadecimal (negative)", IntegerFormat{Base: 16}, int8(-128), "int8(-0x80)"},
+		{"octal", IntegerFormat{Base: 8}, 0755, "int(0o755)"},
+		{"binary", IntegerFormat{Base: 2}, uint8(5), "uint8(0b101)"},
+		{"binary (grouped)", IntegerFormat{Base: 2, GroupDigits: true}, uint8(0xa5), "uint8(0b1010_0101)"},
+		{"uintptr is unaffected", IntegerFormat{Base: 2}, uintptr(0xabcd), "uintptr(0xabcd)"},
+	}
+
+	for _, c := range cases {
+		testWithPrinter(
+			t,
+			NewPrinter(WithDefaultIntegerFormat(c.Format)),
+			c.Name,
+			c.Value,
+			c.Output,
+		)
+	}
+}
+
+func TestPrinter_WithIntegerFormat(t *testing.T) {
+	type flags uint8
+
+	type named struct {
+		Flags flags
+		Count uint8
+	}
+
+	p := NewPrinter(
+		WithDefaultIntegerFormat(IntegerFormat{GroupDigits: true}),
+		WithIntegerFormat[flags](IntegerFormat{Base: 2}),
+		WithIntegerFormat[uintptr](IntegerFormat{Base: 10, GroupDigits: true}),
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"type-specific format takes precedence",
+		named{Flags: 5, Count: 5},
+		"github.com/dogmatiq/dapper_test.named{",
+		"    Flags: 0b101",
+		"    Count: 5",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"uintptr",
+		uintptr(1234567),
+		"uintptr(1_234_567)",
+	)
+}
+
+func TestWithIntegerFormat_unsupportedBase(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+
+	WithIntegerFormat[int](IntegerFormat{Base: 3})
+}
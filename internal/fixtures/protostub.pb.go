@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.11
 // 	protoc        v6.32.0
 // source: github.com/dogmatiq/dapper/internal/fixtures/protostub.proto
 
@@ -234,6 +234,128 @@ func (x *Stringer) GetValue() string {
 	return ""
 }
 
+type Composite struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OptionalInt *int32                 `protobuf:"varint,1,opt,name=optional_int,json=optionalInt,proto3,oneof" json:"optional_int,omitempty"`
+	List        []string               `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	Map         map[string]*Nested     `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IntMap      map[int32]string       `protobuf:"bytes,4,rep,name=int_map,json=intMap,proto3" json:"int_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Composite_ChoiceStr
+	//	*Composite_ChoiceNested
+	Choice        isComposite_Choice `protobuf_oneof:"choice"`
+	Enums         []Enum             `protobuf:"varint,7,rep,packed,name=enums,proto3,enum=dogmatiq.dapper.fixtures.Enum" json:"enums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Composite) Reset() {
+	*x = Composite{}
+	mi := &file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Composite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Composite) ProtoMessage() {}
+
+func (x *Composite) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Composite.ProtoReflect.Descriptor instead.
+func (*Composite) Descriptor() ([]byte, []int) {
+	return file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Composite) GetOptionalInt() int32 {
+	if x != nil && x.OptionalInt != nil {
+		return *x.OptionalInt
+	}
+	return 0
+}
+
+func (x *Composite) GetList() []string {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Composite) GetMap() map[string]*Nested {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *Composite) GetIntMap() map[int32]string {
+	if x != nil {
+		return x.IntMap
+	}
+	return nil
+}
+
+func (x *Composite) GetChoice() isComposite_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Composite) GetChoiceStr() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Composite_ChoiceStr); ok {
+			return x.ChoiceStr
+		}
+	}
+	return ""
+}
+
+func (x *Composite) GetChoiceNested() *Nested {
+	if x != nil {
+		if x, ok := x.Choice.(*Composite_ChoiceNested); ok {
+			return x.ChoiceNested
+		}
+	}
+	return nil
+}
+
+func (x *Composite) GetEnums() []Enum {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+type isComposite_Choice interface {
+	isComposite_Choice()
+}
+
+type Composite_ChoiceStr struct {
+	ChoiceStr string `protobuf:"bytes,5,opt,name=choice_str,json=choiceStr,proto3,oneof"`
+}
+
+type Composite_ChoiceNested struct {
+	ChoiceNested *Nested `protobuf:"bytes,6,opt,name=choice_nested,json=choiceNested,proto3,oneof"`
+}
+
+func (*Composite_ChoiceStr) isComposite_Choice() {}
+
+func (*Composite_ChoiceNested) isComposite_Choice() {}
+
 var File_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto protoreflect.FileDescriptor
 
 const file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDesc = "" +
@@ -248,7 +370,24 @@ const file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDesc
 	"\bnested_a\x18\x01 \x01(\tR\anestedA\x12\x19\n" +
 	"\bnested_b\x18\x02 \x01(\fR\anestedB\" \n" +
 	"\bStringer\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value*%\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xa1\x04\n" +
+	"\tComposite\x12&\n" +
+	"\foptional_int\x18\x01 \x01(\x05H\x01R\voptionalInt\x88\x01\x01\x12\x12\n" +
+	"\x04list\x18\x02 \x03(\tR\x04list\x12>\n" +
+	"\x03map\x18\x03 \x03(\v2,.dogmatiq.dapper.fixtures.Composite.MapEntryR\x03map\x12H\n" +
+	"\aint_map\x18\x04 \x03(\v2/.dogmatiq.dapper.fixtures.Composite.IntMapEntryR\x06intMap\x12\x1f\n" +
+	"\n" +
+	"choice_str\x18\x05 \x01(\tH\x00R\tchoiceStr\x12G\n" +
+	"\rchoice_nested\x18\x06 \x01(\v2 .dogmatiq.dapper.fixtures.NestedH\x00R\fchoiceNested\x124\n" +
+	"\x05enums\x18\a \x03(\x0e2\x1e.dogmatiq.dapper.fixtures.EnumR\x05enums\x1aX\n" +
+	"\bMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .dogmatiq.dapper.fixtures.NestedR\x05value:\x028\x01\x1a9\n" +
+	"\vIntMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06choiceB\x0f\n" +
+	"\r_optional_int*%\n" +
 	"\x04Enum\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\a\n" +
 	"\x03FOO\x10\x01\x12\a\n" +
@@ -267,22 +406,30 @@ func file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDescGZ
 }
 
 var file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_goTypes = []any{
-	(Enum)(0),        // 0: dogmatiq.dapper.fixtures.Enum
-	(*Message)(nil),  // 1: dogmatiq.dapper.fixtures.Message
-	(*Nested)(nil),   // 2: dogmatiq.dapper.fixtures.Nested
-	(*Stringer)(nil), // 3: dogmatiq.dapper.fixtures.Stringer
+	(Enum)(0),         // 0: dogmatiq.dapper.fixtures.Enum
+	(*Message)(nil),   // 1: dogmatiq.dapper.fixtures.Message
+	(*Nested)(nil),    // 2: dogmatiq.dapper.fixtures.Nested
+	(*Stringer)(nil),  // 3: dogmatiq.dapper.fixtures.Stringer
+	(*Composite)(nil), // 4: dogmatiq.dapper.fixtures.Composite
+	nil,               // 5: dogmatiq.dapper.fixtures.Composite.MapEntry
+	nil,               // 6: dogmatiq.dapper.fixtures.Composite.IntMapEntry
 }
 var file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_depIdxs = []int32{
 	0, // 0: dogmatiq.dapper.fixtures.Message.enum:type_name -> dogmatiq.dapper.fixtures.Enum
 	2, // 1: dogmatiq.dapper.fixtures.Message.nested:type_name -> dogmatiq.dapper.fixtures.Nested
 	3, // 2: dogmatiq.dapper.fixtures.Message.stringer:type_name -> dogmatiq.dapper.fixtures.Stringer
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 3: dogmatiq.dapper.fixtures.Composite.map:type_name -> dogmatiq.dapper.fixtures.Composite.MapEntry
+	6, // 4: dogmatiq.dapper.fixtures.Composite.int_map:type_name -> dogmatiq.dapper.fixtures.Composite.IntMapEntry
+	2, // 5: dogmatiq.dapper.fixtures.Composite.choice_nested:type_name -> dogmatiq.dapper.fixtures.Nested
+	0, // 6: dogmatiq.dapper.fixtures.Composite.enums:type_name -> dogmatiq.dapper.fixtures.Enum
+	2, // 7: dogmatiq.dapper.fixtures.Composite.MapEntry.value:type_name -> dogmatiq.dapper.fixtures.Nested
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_init() }
@@ -290,13 +437,17 @@ func file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_init() {
 	if File_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto != nil {
 		return
 	}
+	file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_msgTypes[3].OneofWrappers = []any{
+		(*Composite_ChoiceStr)(nil),
+		(*Composite_ChoiceNested)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDesc), len(file_github_com_dogmatiq_dapper_internal_fixtures_protostub_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
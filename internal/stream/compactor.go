@@ -0,0 +1,83 @@
+package stream
+
+import (
+	"io"
+)
+
+// Compactor is an [io.Writer] that joins multiple lines of text into a single
+// line.
+//
+// Leading whitespace is removed from each line, and any alignment padding
+// after the first ": " on each line is removed. Lines are separated by ", "
+// unless the previous line ends with an opening brace or the next line begins
+// with a closing brace.
+type Compactor struct {
+	Target io.Writer
+
+	started        bool // true once any non-whitespace has been written
+	atLineStart    bool // true while skipping leading whitespace
+	pendingNewLine bool // true if a line break has been consumed
+	skipPadding    bool // true while skipping alignment padding
+	keyDone        bool // true once the first ": " on the line has been seen
+	inQuote        bool // true while inside a double-quoted string
+	escaped        bool // true if the previous byte was an escape character
+	last           byte // the last byte written
+}
+
+func (w *Compactor) Write(data []byte) (int, error) {
+	buf := make([]byte, 0, len(data))
+
+	for _, c := range data {
+		if c == '\n' {
+			w.pendingNewLine = w.started
+			w.atLineStart = true
+			w.skipPadding = false
+			w.keyDone = false
+			w.inQuote = false
+			w.escaped = false
+			continue
+		}
+
+		if w.atLineStart || w.skipPadding {
+			if c == ' ' || c == '\t' {
+				continue
+			}
+
+			w.atLineStart = false
+			w.skipPadding = false
+		}
+
+		if w.pendingNewLine {
+			if w.last != '{' && c != '}' {
+				buf = append(buf, ',', ' ')
+			}
+			w.pendingNewLine = false
+		}
+
+		buf = append(buf, c)
+
+		if w.inQuote {
+			if w.escaped {
+				w.escaped = false
+			} else if c == '\\' {
+				w.escaped = true
+			} else if c == '"' {
+				w.inQuote = false
+			}
+		} else if c == '"' {
+			w.inQuote = true
+		} else if c == ' ' && w.last == ':' && !w.keyDone {
+			w.keyDone = true
+			w.skipPadding = true
+		}
+
+		w.started = true
+		w.last = c
+	}
+
+	if _, err := w.Target.Write(buf); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
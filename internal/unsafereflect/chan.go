@@ -0,0 +1,201 @@
+package unsafereflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// ChanState is a snapshot of the internal state of a channel.
+type ChanState struct {
+	// Buffer contains copies of the values in the channel's buffer, in the
+	// order that they would be received.
+	Buffer []reflect.Value
+
+	// IsClosed is true if the channel has been closed.
+	IsClosed bool
+
+	// Senders is the number of goroutines blocked sending to the channel.
+	Senders int
+
+	// Receivers is the number of goroutines blocked receiving from the
+	// channel.
+	Receivers int
+}
+
+// InspectChan returns a snapshot of the internal state of the channel v
+// without receiving any values from it.
+//
+// The snapshot is taken without acquiring the channel's lock, so it may be
+// inconsistent if the channel is in use by other goroutines. It returns false
+// if v is nil, or if the layout of the runtime's channel implementation is not
+// recognized.
+func InspectChan(v reflect.Value) (ChanState, bool) {
+	if chanErr != nil {
+		// CODE COVERAGE: This branch is never executed unless the internals of
+		// the runtime package have changed in some incompatible way.
+		return ChanState{}, false
+	}
+
+	return inspectChan(v)
+}
+
+// hchan is defined equivalently to the unexported runtime.hchan type.
+//
+// Only the fields up to and including the wait queues are defined, as those
+// after it are not needed.
+type hchan struct {
+	qcount   uint
+	dataqsiz uint
+	buf      unsafe.Pointer
+	elemsize uint16
+	closed   uint32
+	timer    unsafe.Pointer
+	elemtype unsafe.Pointer
+	sendx    uint
+	recvx    uint
+	recvq    waitq
+	sendq    waitq
+}
+
+// waitq is defined equivalently to the unexported runtime.waitq type.
+type waitq struct {
+	first *sudog
+	last  *sudog
+}
+
+// sudog is defined equivalently to the unexported runtime.sudog type.
+//
+// Only the fields required to traverse the wait queue are defined.
+type sudog struct {
+	g    unsafe.Pointer
+	next *sudog
+	prev *sudog
+}
+
+// maxWaiters is the maximum number of waiting goroutines to count before
+// assuming the wait queue is corrupt, or has been modified concurrently.
+const maxWaiters = 1 << 20
+
+// chanErr is non-nil if there is a problem verifying the layout of the
+// runtime's channel implementation.
+var chanErr error
+
+func inspectChan(v reflect.Value) (ChanState, bool) {
+	if v.Kind() != reflect.Chan || v.IsNil() {
+		return ChanState{}, false
+	}
+
+	elemType := v.Type().Elem()
+	h := (*hchan)(v.UnsafePointer())
+
+	// Verify that the fields we know about have the values we expect, as a
+	// guard against changes to the runtime's channel implementation.
+	if h.dataqsiz != uint(v.Cap()) ||
+		h.qcount != uint(v.Len()) ||
+		h.qcount > h.dataqsiz ||
+		h.elemsize != uint16(elemType.Size()) ||
+		h.elemtype != typePointer(elemType) ||
+		h.closed > 1 {
+		return ChanState{}, false
+	}
+
+	if h.dataqsiz == 0 {
+		if h.sendx != 0 || h.recvx != 0 {
+			return ChanState{}, false
+		}
+	} else if h.sendx >= h.dataqsiz || h.recvx >= h.dataqsiz {
+		return ChanState{}, false
+	}
+
+	senders, ok := h.sendq.len()
+	if !ok {
+		return ChanState{}, false
+	}
+
+	receivers, ok := h.recvq.len()
+	if !ok {
+		return ChanState{}, false
+	}
+
+	state := ChanState{
+		IsClosed:  h.closed != 0,
+		Senders:   senders,
+		Receivers: receivers,
+	}
+
+	for i := uint(0); i < h.qcount; i++ {
+		index := (h.recvx + i) % h.dataqsiz
+		ptr := unsafe.Add(h.buf, uintptr(index)*uintptr(h.elemsize))
+
+		elem := reflect.New(elemType).Elem()
+		elem.Set(reflect.NewAt(elemType, ptr).Elem())
+
+		state.Buffer = append(state.Buffer, elem)
+	}
+
+	return state, true
+}
+
+// len returns the number of goroutines in the wait queue.
+func (q *waitq) len() (int, bool) {
+	n := 0
+
+	for s := q.first; s != nil; s = s.next {
+		n++
+
+		if n > maxWaiters {
+			return 0, false
+		}
+	}
+
+	return n, true
+}
+
+// typePointer returns the address of the runtime's type descriptor for t.
+func typePointer(t reflect.Type) unsafe.Pointer {
+	// The data word of the interface value is a pointer to the reflect.rtype
+	// struct, which embeds the runtime's type descriptor as its first field.
+	return (*[2]unsafe.Pointer)(unsafe.Pointer(&t))[1]
+}
+
+// checkChanLayout verifies that the locally defined hchan type matches the
+// runtime's implementation, by inspecting a channel with known state.
+func checkChanLayout() error {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	<-ch
+	ch <- 4
+	close(ch)
+
+	state, ok := inspectChan(reflect.ValueOf(ch))
+	if !ok {
+		// CODE COVERAGE: This branch is never executed unless the internals of
+		// the runtime package have changed in some incompatible way.
+		return errors.New("runtime.hchan layout is not recognized")
+	}
+
+	var values []int64
+	for _, v := range state.Buffer {
+		values = append(values, v.Int())
+	}
+
+	if !state.IsClosed || fmt.Sprint(values) != "[2 3 4]" {
+		// CODE COVERAGE: This branch is never executed unless the internals of
+		// the runtime package have changed in some incompatible way.
+		return fmt.Errorf(
+			"runtime.hchan layout produced unexpected state (closed: %t, buffer: %v)",
+			state.IsClosed,
+			values,
+		)
+	}
+
+	return nil
+}
+
+func init() {
+	chanErr = checkChanLayout()
+}
@@ -0,0 +1,73 @@
+package unsafereflect
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInspectChan(t *testing.T) {
+	ch := make(chan string, 5)
+	ch <- "a"
+	ch <- "b"
+
+	state, ok := InspectChan(reflect.ValueOf(ch))
+	if !ok {
+		t.Fatal("expected channel to be inspected")
+	}
+
+	if len(state.Buffer) != 2 || state.Buffer[0].String() != "a" || state.Buffer[1].String() != "b" {
+		t.Fatalf("unexpected buffer: %v", state.Buffer)
+	}
+
+	if state.IsClosed {
+		t.Fatal("expected channel to be open")
+	}
+
+	if len(ch) != 2 {
+		t.Fatal("expected values to remain in the channel")
+	}
+}
+
+func TestInspectChan_waiters(t *testing.T) {
+	ch := make(chan int)
+
+	go func() { ch <- 1 }()
+	go func() { ch <- 2 }()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		state, ok := InspectChan(reflect.ValueOf(ch))
+		if !ok {
+			t.Fatal("expected channel to be inspected")
+		}
+
+		if state.Senders == 2 && state.Receivers == 0 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected waiters: %+v", state)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	<-ch
+	<-ch
+}
+
+func TestInspectChan_nil(t *testing.T) {
+	if _, ok := InspectChan(reflect.ValueOf((chan int)(nil))); ok {
+		t.Fatal("expected nil channel to be rejected")
+	}
+}
+
+// This test will fail if the internals of the runtime package have changed
+// such that the channel layout is no longer recognized.
+func TestChanLayout(t *testing.T) {
+	if chanErr != nil {
+		t.Fatal(chanErr)
+	}
+}
@@ -0,0 +1,23 @@
+package unsafereflect
+
+import "reflect"
+
+// FuncClosure returns the address of the closure that the function value v
+// refers to, or zero if v is nil.
+//
+// Unlike [reflect.Value.Pointer], which returns the address of the function's
+// code, the address of the closure differs between function values created
+// from the same function literal with different captured variables, and
+// between method values bound to different receivers.
+func FuncClosure(v reflect.Value) uintptr {
+	if v.IsNil() {
+		return 0
+	}
+
+	// A function value is a pointer to the closure, so we copy it to a
+	// variable and read the pointer directly.
+	p := reflect.New(v.Type())
+	p.Elem().Set(MakeMutable(v))
+
+	return *(*uintptr)(p.UnsafePointer())
+}
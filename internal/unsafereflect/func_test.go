@@ -0,0 +1,33 @@
+package unsafereflect
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuncClosure(t *testing.T) {
+	closure := func(n int) func() int {
+		return func() int { return n }
+	}
+
+	a := reflect.ValueOf(closure(1))
+	b := reflect.ValueOf(closure(2))
+
+	if a.Pointer() != b.Pointer() {
+		t.Fatal("expected closures to share the same code pointer")
+	}
+
+	if FuncClosure(a) == FuncClosure(b) {
+		t.Fatal("expected closures to have different closure pointers")
+	}
+
+	if FuncClosure(a) != FuncClosure(a) {
+		t.Fatal("expected the closure pointer to be stable")
+	}
+}
+
+func TestFuncClosure_nil(t *testing.T) {
+	if p := FuncClosure(reflect.ValueOf((func())(nil))); p != 0 {
+		t.Fatalf("unexpected pointer: %#x", p)
+	}
+}
@@ -53,8 +53,9 @@ func renderSliceType(r Renderer, c Config, t reflect.Type) {
 func renderArrayElements(r Renderer, v Value) {
 	staticType := v.DynamicType.Elem()
 	isInterface := staticType.Kind() == reflect.Interface
+	n := elementLimit(r, v.Value.Len())
 
-	for i := 0; i < v.Value.Len(); i++ {
+	for i := 0; i < n; i++ {
 		elem := v.Value.Index(i)
 
 		r.WriteValue(
@@ -69,19 +70,50 @@ func renderArrayElements(r Renderer, v Value) {
 		)
 		r.Print("\n")
 	}
+
+	renderMoreElements(r, v.Value.Len()-n)
 }
 
 func renderByteArrayElements(r Renderer, v Value) {
+	n := elementLimit(r, v.Value.Len())
 	d := hex.Dumper(r)
-	defer d.Close()
 
 	data := make([]byte, 1)
 
-	for i := 0; i < v.Value.Len(); i++ {
+	for i := 0; i < n; i++ {
 		data[0] = byte(v.Value.Index(i).Uint())
 
 		if _, err := d.Write(data); err != nil {
 			panic(panicSentinel{err})
 		}
 	}
+
+	if err := d.Close(); err != nil {
+		panic(panicSentinel{err})
+	}
+
+	renderMoreElements(r, v.Value.Len()-n)
+}
+
+// elementLimit returns the number of elements to render from a collection of
+// n elements, according to [Config.MaxElements].
+func elementLimit(r Renderer, n int) int {
+	max := configOf(r).MaxElements
+
+	switch {
+	case max < 0:
+		return 0
+	case max > 0 && n > max:
+		return max
+	default:
+		return n
+	}
+}
+
+// renderMoreElements renders a line indicating that n elements of a
+// collection were not rendered, if n is non-zero.
+func renderMoreElements(r Renderer, n int) {
+	if n > 0 {
+		r.Print("<%d more element(s)>\n", n)
+	}
 }
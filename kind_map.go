@@ -21,10 +21,13 @@ func renderMapKind(r Renderer, v Value) {
 		v.DynamicType.Key(),
 		v.DynamicType.Elem(),
 		func(emit func(k, v reflect.Value)) {
-			for _, k := range v.Value.MapKeys() {
+			// Use an iterator rather than looking up each key, as entries
+			// with NaN keys can not be looked up.
+			iter := v.Value.MapRange()
+			for iter.Next() {
 				emit(
-					k,
-					v.Value.MapIndex(k),
+					iter.Key(),
+					iter.Value(),
 				)
 			}
 		},
@@ -38,6 +41,15 @@ func renderMapType(r Renderer, c Config, t reflect.Type) {
 	renderType(r, c, t.Elem())
 }
 
+// mapPair is a pre-rendered key/value pair.
+type mapPair struct {
+	KeyWidth int
+	Key      string
+	Value    string
+	key      reflect.Value
+	value    reflect.Value
+}
+
 // randerMap renders a map-like structure.
 func renderMap(
 	r Renderer,
@@ -45,13 +57,6 @@ func renderMap(
 	kt, vt reflect.Type,
 	each func(emit func(k, v reflect.Value)),
 ) {
-	// mapPair is a pre-rendered key/value pair.
-	type mapPair struct {
-		KeyWidth int
-		Key      string
-		Value    string
-	}
-
 	// lineWidths returns the number of bytes in the longest and last line of s.
 	lineWidths := func(s string) (max int, last int) {
 		for {
@@ -74,50 +79,37 @@ func renderMap(
 		}
 	}
 
-	var (
-		pairs           []mapPair
-		alignment       int
-		alignToLastLine bool
-	)
-
-	// Iterate over the key/value pairs in the map to produce a pre-rendered set
-	// of pairs and alignment information.
-	each(
-		func(k, v reflect.Value) {
-			ks := r.FormatValue(
-				Value{
-					Value:                  k,
-					DynamicType:            k.Type(),
-					StaticType:             kt,
-					IsAmbiguousDynamicType: kt.Kind() == reflect.Interface,
-					IsAmbiguousStaticType:  false,
-					IsUnexported:           m.IsUnexported,
-				},
-			)
+	keyValue := func(k reflect.Value) Value {
+		return Value{
+			Value:                  k,
+			DynamicType:            k.Type(),
+			StaticType:             kt,
+			IsAmbiguousDynamicType: kt.Kind() == reflect.Interface,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           m.IsUnexported,
+		}
+	}
 
-			vs := r.FormatValue(
-				Value{
-					Value:                  v,
-					DynamicType:            v.Type(),
-					StaticType:             vt,
-					IsAmbiguousDynamicType: vt.Kind() == reflect.Interface,
-					IsAmbiguousStaticType:  false,
-					IsUnexported:           m.IsUnexported,
-				},
-			)
+	elemValue := func(v reflect.Value) Value {
+		return Value{
+			Value:                  v,
+			DynamicType:            v.Type(),
+			StaticType:             vt,
+			IsAmbiguousDynamicType: vt.Kind() == reflect.Interface,
+			IsAmbiguousStaticType:  false,
+			IsUnexported:           m.IsUnexported,
+		}
+	}
 
-			max, last := lineWidths(ks)
-			if max > alignment {
-				alignment = max
-				alignToLastLine = max == last
-			}
+	var pairs []mapPair
 
+	each(
+		func(k, v reflect.Value) {
 			pairs = append(
 				pairs,
 				mapPair{
-					KeyWidth: last,
-					Key:      ks,
-					Value:    vs,
+					key:   k,
+					value: v,
 				},
 			)
 		},
@@ -132,21 +124,86 @@ func renderMap(
 		return
 	}
 
+	cfg := configOf(r)
+
+	// final is true if the pairs are rendered with their final pointer
+	// identities before they are sorted.
+	final := true
+
+	switch {
+	case cfg.StablePointers && !cfg.deferPointerIDs:
+		// Keys are visited in the map's iteration order, which is random.
+		// Render the keys and values without assigning pointer identities so
+		// that the pairs can be sorted first, then assign the identities in
+		// the order that the keys are displayed.
+		final = false
+		formatMapPairs(
+			r.WithModifiedConfig(
+				func(c *Config) {
+					c.deferPointerIDs = true
+				},
+			),
+			pairs,
+			keyValue,
+			elemValue,
+		)
+		sortMapPairs(pairs)
+	case cfg.StablePointers:
+		// This map is nested within a value that is already being rendered
+		// without pointer identities, so the pairs are rendered only once.
+		formatMapPairs(r, pairs, keyValue, elemValue)
+		sortMapPairs(pairs)
+	default:
+		for i := range pairs {
+			pairs[i].Key = formatNestedValue(r, keyValue(pairs[i].key))
+		}
+
+		sort.Slice(
+			pairs,
+			func(i, j int) bool {
+				return natsort.Less(
+					pairs[i].Key,
+					pairs[j].Key,
+				)
+			},
+		)
+	}
+
+	n := elementLimit(r, len(pairs))
+	more := len(pairs) - n
+	pairs = pairs[:n]
+
+	if !final {
+		formatMapPairs(r, pairs, keyValue, elemValue)
+	} else if !cfg.StablePointers {
+		// Render the values only once the keys have been sorted, so that
+		// values are always visited in the same order, regardless of the
+		// map's iteration order.
+		for i := range pairs {
+			pairs[i].Value = formatNestedValue(r, elemValue(pairs[i].value))
+		}
+	}
+
+	var (
+		alignment       int
+		alignToLastLine bool
+	)
+
+	for i := range pairs {
+		max, last := lineWidths(pairs[i].Key)
+		if max > alignment {
+			alignment = max
+			alignToLastLine = max == last
+		}
+
+		pairs[i].KeyWidth = last
+	}
+
 	// compensate for the ":" added to the last line
 	if !alignToLastLine {
 		alignment--
 	}
 
-	sort.Slice(
-		pairs,
-		func(i, j int) bool {
-			return natsort.Less(
-				pairs[i].Key,
-				pairs[j].Key,
-			)
-		},
-	)
-
 	r.Print("{\n")
 	r.Indent()
 
@@ -162,6 +219,48 @@ func renderMap(
 		r.Print("%s\n", p.Value)
 	}
 
+	renderMoreElements(r, more)
+
 	r.Outdent()
 	r.Print("}")
 }
+
+// formatMapPairs renders the keys and values of each pair using r.
+func formatMapPairs(
+	r Renderer,
+	pairs []mapPair,
+	keyValue, elemValue func(reflect.Value) Value,
+) {
+	for i := range pairs {
+		pairs[i].Key = formatNestedValue(r, keyValue(pairs[i].key))
+		pairs[i].Value = formatNestedValue(r, elemValue(pairs[i].value))
+	}
+}
+
+// sortMapPairs sorts pre-rendered pairs by their keys, then by their values.
+//
+// Pairs that differ only by the addresses they contain are indistinguishable,
+// so their relative order is still random.
+func sortMapPairs(pairs []mapPair) {
+	sort.SliceStable(
+		pairs,
+		func(i, j int) bool {
+			if pairs[i].Key != pairs[j].Key {
+				return natsort.Less(pairs[i].Key, pairs[j].Key)
+			}
+			return natsort.Less(pairs[i].Value, pairs[j].Value)
+		},
+	)
+}
+
+// formatNestedValue returns the representation of v as rendered within a block
+// opened by r, such that the depth of v is correct when it is rendered before
+// the block itself.
+func formatNestedValue(r Renderer, v Value) string {
+	if x, ok := r.(*renderer); ok {
+		x.Depth++
+		defer func() { x.Depth-- }()
+	}
+
+	return r.FormatValue(v)
+}
@@ -1,6 +1,9 @@
 package dapper_test
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 type maps struct {
 	Ints        map[int]int
@@ -27,6 +30,15 @@ func TestPrinter_Map(t *testing.T) {
 		"    2: 200",
 		"}",
 	)
+
+	test(
+		t,
+		"NaN key",
+		map[float64]int{math.NaN(): 1},
+		"map[float64]int{",
+		"    NaN: 1",
+		"}",
+	)
 }
 
 // This test verifies the formatting of map key/values when the type information
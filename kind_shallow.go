@@ -3,7 +3,10 @@ package dapper
 import (
 	"fmt"
 	"reflect"
-	"strconv"
+	"runtime"
+	"strings"
+
+	"github.com/dogmatiq/dapper/internal/unsafereflect"
 )
 
 // renderNil renders a nil value of any type.
@@ -42,22 +45,26 @@ func renderBoolKind(r Renderer, v Value) {
 // renderIntKind renders a [reflect.Int], [reflect.Int8], [reflect.Int16],
 // [reflect.Int32] or [reflect.Int64] value.
 func renderIntKind(r Renderer, v Value) {
+	f, _ := integerFormat(configOf(r), v.DynamicType)
+
 	printWithTypeIfAmbiguous(
 		r,
 		v,
-		"%v",
-		v.Value.Int(),
+		"%s",
+		formatInt(v.Value.Int(), f),
 	)
 }
 
 // renderUintKind renders a [reflect.Uint], [reflect.Uint8], [reflect.Uint16],
 // [reflect.Uint32] or [reflect.Uint64] value.
 func renderUintKind(r Renderer, v Value) {
+	f, _ := integerFormat(configOf(r), v.DynamicType)
+
 	printWithTypeIfAmbiguous(
 		r,
 		v,
-		"%v",
-		v.Value.Uint(),
+		"%s",
+		formatUint(v.Value.Uint(), f),
 	)
 }
 
@@ -87,11 +94,20 @@ func renderComplexKind(r Renderer, v Value) {
 
 // renderUintptrKind renders a [reflect.Uintptr] value.
 func renderUintptrKind(r Renderer, v Value) {
+	s := formatPointer(r, "uintptr", uintptr(v.Value.Uint()), false)
+
+	// Only use the integer formatting options if a format has been specified
+	// for this specific type, otherwise uintptr values are rendered the same
+	// as any other pointer.
+	if f, ok := integerFormat(configOf(r), v.DynamicType); ok {
+		s = formatUint(v.Value.Uint(), f)
+	}
+
 	printWithTypeIfAmbiguous(
 		r,
 		v,
 		"%s",
-		formatPointer(uintptr(v.Value.Uint()), false),
+		s,
 	)
 }
 
@@ -101,13 +117,20 @@ func renderUnsafePointerKind(r Renderer, v Value) {
 		r,
 		v,
 		"%s",
-		formatPointer(v.Value.Pointer(), true),
+		formatPointer(r, "ptr", v.Value.Pointer(), true),
 	)
 }
 
 // renderChanKind renders a [reflect.Chan] value.
 func renderChanKind(r Renderer, v Value) {
-	ptr := formatPointer(v.Value.Pointer(), true)
+	ptr := formatPointer(r, "chan", v.Value.Pointer(), true)
+
+	if configOf(r).RenderChanContents {
+		if state, ok := unsafereflect.InspectChan(v.Value); ok {
+			renderChanState(r, v, ptr, state)
+			return
+		}
+	}
 
 	if v.Value.IsNil() || v.Value.Cap() == 0 {
 		printWithTypeIfAmbiguous(
@@ -126,7 +149,64 @@ func renderChanKind(r Renderer, v Value) {
 			v.Value.Cap(),
 		)
 	}
+}
+
+// renderChanState renders a [reflect.Chan] value, including its buffered
+// values and the state obtained by inspecting the channel's internals.
+func renderChanState(r Renderer, v Value, ptr string, state unsafereflect.ChanState) {
+	desc := ptr
+
+	if n := v.Value.Cap(); n != 0 {
+		desc += fmt.Sprintf(" %d/%d", len(state.Buffer), n)
+	}
+
+	var flags []string
+	if state.IsClosed {
+		flags = append(flags, "closed")
+	}
+	if state.Senders != 0 {
+		flags = append(flags, fmt.Sprintf("senders: %d", state.Senders))
+	}
+	if state.Receivers != 0 {
+		flags = append(flags, fmt.Sprintf("receivers: %d", state.Receivers))
+	}
+
+	if len(flags) != 0 {
+		desc += " <" + strings.Join(flags, ", ") + ">"
+	}
+
+	printWithTypeIfAmbiguous(r, v, "%s", desc)
+
+	if len(state.Buffer) == 0 {
+		return
+	}
+
+	staticType := v.DynamicType.Elem()
+	isInterface := staticType.Kind() == reflect.Interface
+
+	r.Print(" {\n")
+	r.Indent()
+
+	n := elementLimit(r, len(state.Buffer))
+
+	for _, elem := range state.Buffer[:n] {
+		r.WriteValue(
+			Value{
+				Value:                  elem,
+				DynamicType:            elem.Type(),
+				StaticType:             staticType,
+				IsAmbiguousDynamicType: isInterface,
+				IsAmbiguousStaticType:  false,
+				IsUnexported:           v.IsUnexported,
+			},
+		)
+		r.Print("\n")
+	}
+
+	renderMoreElements(r, len(state.Buffer)-n)
 
+	r.Outdent()
+	r.Print("}")
 }
 
 func renderChanType(r Renderer, c Config, t reflect.Type) {
@@ -154,7 +234,7 @@ func renderFuncKind(r Renderer, v Value) {
 		r,
 		v,
 		"%s",
-		formatPointer(v.Value.Pointer(), true),
+		formatFunc(r, v.Value),
 	)
 }
 
@@ -199,8 +279,80 @@ func renderFuncType(r Renderer, c Config, t reflect.Type) {
 	r.Print(")")
 }
 
+// formatFunc returns the fully-qualified name of the function v, falling back
+// to its address if the name can not be determined.
+//
+// Closures are named after the function they are declared in, such as
+// "pkg.Outer.func1". Method values are named after the method itself. If
+// stable pointers are enabled, the names of closures and method values are
+// followed by the identity of the closure, such as "pkg.Outer.func1#1", so
+// that closures with different captured variables can be distinguished.
+func formatFunc(r Renderer, v reflect.Value) string {
+	p := v.Pointer()
+	fn := runtime.FuncForPC(p)
+	if p == 0 || fn == nil {
+		return formatPointer(r, "func", p, true)
+	}
+
+	cfg := configOf(r)
+
+	// The compiler generates a wrapper function with an "-fm" suffix for
+	// method values, which is an implementation detail of no interest to the
+	// user.
+	name, isMethodValue := strings.CutSuffix(fn.Name(), "-fm")
+	name = formatFuncName(cfg, name)
+
+	if cfg.StablePointers && (isMethodValue || isClosureName(name)) {
+		name = formatPointer(r, name, unsafereflect.FuncClosure(v), true)
+	}
+
+	if cfg.RenderFuncSourceLocations {
+		file, line := fn.FileLine(fn.Entry())
+
+		// Compiler-generated wrappers do not have a meaningful location.
+		if file != "" && file != "<autogenerated>" {
+			name += fmt.Sprintf(" (%s:%d)", file, line)
+		}
+	}
+
+	return name
+}
+
+// isClosureName returns true if name is the name that the compiler assigns to
+// a function literal, such as "pkg.Outer.func1" or "pkg.Outer.func1.func2".
+func isClosureName(name string) bool {
+	if i := strings.LastIndexByte(name, '/'); i != -1 {
+		name = name[i+1:]
+	}
+
+	outer, ok := strings.CutSuffix(
+		strings.TrimRight(name, "0123456789."),
+		".func",
+	)
+
+	// The name of the package alone is not sufficient, as closures are always
+	// named after the function they are declared in.
+	return ok && strings.Contains(outer, ".")
+}
+
+// formatFuncName returns the package path-qualified function name, as
+// reported by the runtime, with the package path removed if c requires it.
+func formatFuncName(c *Config, name string) string {
+	if !c.RenderPackagePaths {
+		if i := strings.LastIndexByte(name, '/'); i != -1 {
+			name = name[i+1:]
+		}
+	}
+
+	return name
+}
+
 // formatPointer returns a minimal hexadecimal represenation of p.
-func formatPointer(p uintptr, zeroIsNil bool) string {
+//
+// If stable pointers are enabled, the address is replaced with an identity of
+// the form "<label>#<n>", where n is the order in which the address was first
+// encountered.
+func formatPointer(r Renderer, label string, p uintptr, zeroIsNil bool) string {
 	if p == 0 {
 		if zeroIsNil {
 			return "nil"
@@ -209,5 +361,12 @@ func formatPointer(p uintptr, zeroIsNil bool) string {
 		return "0"
 	}
 
-	return "0x" + strconv.FormatUint(uint64(p), 16)
+	if cfg := configOf(r); cfg.StablePointers {
+		if cfg.deferPointerIDs {
+			return label + "#?"
+		}
+		return fmt.Sprintf("%s#%d", label, r.PointerID(p))
+	}
+
+	return formatUint(uint64(p), IntegerFormat{Base: 16})
 }
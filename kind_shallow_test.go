@@ -2,9 +2,13 @@ package dapper_test
 
 import (
 	"fmt"
-	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 	"unsafe"
+
+	. "github.com/dogmatiq/dapper"
 )
 
 // shallow is a test struct containing fields for each type of "shallow" value.
@@ -60,7 +64,7 @@ var (
 	pointerTarget    int = 123
 	pointerTargetHex     = fmt.Sprintf("0x%x", &pointerTarget)
 	channelHex           = fmt.Sprintf("0x%x", shallowValues.Channel)
-	funcHex              = fmt.Sprintf("0x%x", reflect.ValueOf(shallowValues.Func).Pointer())
+	funcName             = "github.com/dogmatiq/dapper_test.init.func1"
 )
 
 // This test verifies the formatting of "shallow" values.
@@ -90,7 +94,7 @@ func TestPrinter_ShallowValues(t *testing.T) {
 	test(t, "uintptr", shallowValues.Uintptr, "uintptr(0xabcd)")
 	test(t, "unsafe.Pointer", shallowValues.UnsafePointer, "unsafe.Pointer("+pointerTargetHex+")")
 	test(t, "channel", shallowValues.Channel, "(chan string)("+channelHex+")")
-	test(t, "func", shallowValues.Func, "(func(int, string) (bool, error))("+funcHex+")")
+	test(t, "func", shallowValues.Func, "(func(int, string) (bool, error))("+funcName+")")
 }
 
 // This test verifies the formatting of "shallow" values when the type
@@ -121,7 +125,7 @@ func TestPrinter_ShallowValuesInNamedStruct(t *testing.T) {
 		"    Uintptr:       0xabcd",
 		"    UnsafePointer: "+pointerTargetHex,
 		"    Channel:       "+channelHex,
-		"    Func:          "+funcHex,
+		"    Func:          "+funcName,
 		"}",
 	)
 }
@@ -179,7 +183,7 @@ func TestPrinter_ShallowValuesInAnonymousStruct(t *testing.T) {
 		"    Uintptr:       uintptr(0xabcd)",
 		"    UnsafePointer: unsafe.Pointer("+pointerTargetHex+")",
 		"    Channel:       (chan string)("+channelHex+")",
-		"    Func:          (func(int, string) (bool, error))("+funcHex+")",
+		"    Func:          (func(int, string) (bool, error))("+funcName+")",
 		"}",
 	)
 }
@@ -215,6 +219,125 @@ func TestPrinter_Channel(t *testing.T) {
 	)
 }
 
+// This test verifies the rendering of channel contents.
+func TestPrinter_WithChanContents(t *testing.T) {
+	p := NewPrinter(
+		WithChanContents(true),
+		WithStablePointers(true),
+	)
+
+	testWithPrinter(t, p, "nil channel", (chan string)(nil), "(chan string)(nil)")
+	testWithPrinter(t, p, "empty buffered channel", make(chan string, 10), "(chan string)(chan#1 0/10)")
+	testWithPrinter(t, p, "unbuffered channel", make(chan string), "(chan string)(chan#1)")
+
+	ch := make(chan any, 10)
+	ch <- 1
+	ch <- "two"
+
+	testWithPrinter(
+		t,
+		p,
+		"buffered channel",
+		ch,
+		"(chan any)(chan#1 2/10) {",
+		"    int(1)",
+		`    "two"`,
+		"}",
+	)
+
+	if len(ch) != 2 {
+		t.Fatal("values were received from the channel")
+	}
+
+	close(ch)
+
+	testWithPrinter(
+		t,
+		p,
+		"closed channel",
+		ch,
+		"(chan any)(chan#1 2/10 <closed>) {",
+		"    int(1)",
+		`    "two"`,
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(
+			WithChanContents(true),
+			WithStablePointers(true),
+			WithMaxElements(1),
+		),
+		"buffered channel with an element limit",
+		ch,
+		"(chan any)(chan#1 2/10 <closed>) {",
+		"    int(1)",
+		"    <1 more element(s)>",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(
+			WithChanContents(true),
+			WithStablePointers(true),
+			WithMaxDepth(1),
+		),
+		"buffered channel beyond the maximum depth",
+		[]chan any{ch},
+		"[](chan any){",
+		"    chan#1 2/10 <closed> {...}",
+		"}",
+	)
+
+	recursive := make(chan any, 1)
+	recursive <- recursive
+
+	testWithPrinter(
+		t,
+		p,
+		"recursive channel",
+		recursive,
+		"(chan any)(chan#1 1/1) {",
+		"    (chan any)(<recursion>)",
+		"}",
+	)
+
+	type named struct {
+		Ch    chan int
+		Force bool // prevent rendering of the zero-value marker
+	}
+
+	blocked := make(chan int)
+	go func() { blocked <- 1 }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s := p.Format(blocked)
+		if strings.Contains(s, "senders") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the blocked sender to be rendered, last output: %s", s)
+		}
+		runtime.Gosched()
+	}
+
+	testWithPrinter(
+		t,
+		p,
+		"blocked senders",
+		named{Ch: blocked},
+		"github.com/dogmatiq/dapper_test.named{",
+		"    Ch:    chan#1 <senders: 1>",
+		"    Force: false",
+		"}",
+	)
+
+	<-blocked
+}
+
 // This test provides additional tests for function rendering.
 func TestPrinter_Func(t *testing.T) {
 	type named func(int) bool
@@ -232,6 +355,199 @@ func TestPrinter_Func(t *testing.T) {
 	test(t, "everything", (func(local, ...int) (int, bool))(nil), "(func(github.com/dogmatiq/dapper_test.local, ...int) (int, bool))(nil)")
 }
 
+// This test verifies that addresses are replaced with ordinal identities when
+// stable pointers are enabled.
+func TestPrinter_WithStablePointers(t *testing.T) {
+	p := NewPrinter(WithStablePointers(true))
+
+	testWithPrinter(
+		t,
+		p,
+		"shallow values",
+		shallowValues,
+		"github.com/dogmatiq/dapper_test.shallow{",
+		`    String:        "foo\nbar"`,
+		"    Bool:          true",
+		"    Int:           -100",
+		"    Int8:          -100",
+		"    Int16:         -100",
+		"    Int32:         -100",
+		"    Int64:         -100",
+		"    Uint:          100",
+		"    Uint8:         100",
+		"    Uint16:        100",
+		"    Uint32:        100",
+		"    Uint64:        100",
+		"    Complex64:     100+5i",
+		"    Complex128:    100+5i",
+		"    Float32:       1.2300000190734863",
+		"    Float64:       1.23",
+		"    Uintptr:       uintptr#1",
+		"    UnsafePointer: ptr#2",
+		"    Channel:       chan#3",
+		"    Func:          "+funcName+"#4",
+		"}",
+	)
+
+	ch := make(chan int, 10)
+	ch <- 1
+
+	testWithPrinter(
+		t,
+		p,
+		"repeated addresses",
+		[]any{ch, make(chan int), ch, (chan int)(nil), uintptr(0)},
+		"[]any{",
+		"    (chan int)(chan#1 1/10)",
+		"    (chan int)(chan#2)",
+		"    (chan int)(chan#1 1/10)",
+		"    (chan int)(nil)",
+		"    uintptr(0)",
+		"}",
+	)
+
+	testWithPrinter(
+		t,
+		p,
+		"map values are visited in key order",
+		map[string]chan int{
+			"a": make(chan int),
+			"b": make(chan int),
+			"c": make(chan int),
+			"d": make(chan int),
+		},
+		"map[string](chan int){",
+		`    "a": chan#1`,
+		`    "b": chan#2`,
+		`    "c": chan#3`,
+		`    "d": chan#4`,
+		"}",
+	)
+
+	keys := map[chan int]string{
+		make(chan int): "a",
+		make(chan int): "b",
+		make(chan int): "c",
+	}
+
+	testWithPrinter(
+		t,
+		p,
+		"map keys are assigned identities in display order",
+		keys,
+		"map[(chan int)]string{",
+		`    chan#1: "a"`,
+		`    chan#2: "b"`,
+		`    chan#3: "c"`,
+		"}",
+	)
+
+	nested := map[string]map[chan int]string{
+		"y": {make(chan int): "b"},
+		"x": {make(chan int): "a"},
+	}
+
+	testWithPrinter(
+		t,
+		p,
+		"nested map keys are assigned identities in display order",
+		nested,
+		"map[string](map[chan int]string){",
+		`    "x": {`,
+		`        chan#1: "a"`,
+		`    }`,
+		`    "y": {`,
+		`        chan#2: "b"`,
+		`    }`,
+		"}",
+	)
+
+	// Pairs that differ only by their addresses are displayed in a random
+	// order, but the output is still identical.
+	indistinguishable := map[chan int]string{
+		make(chan int): "x",
+		make(chan int): "x",
+		make(chan int): "x",
+	}
+
+	t.Run("map keys are rendered deterministically", func(t *testing.T) {
+		for _, m := range []map[chan int]string{keys, indistinguishable} {
+			want := p.Format(m)
+
+			for range 50 {
+				if got := p.Format(m); got != want {
+					t.Fatalf("output is not deterministic:\n\n%s\n\n%s", want, got)
+				}
+			}
+		}
+	})
+}
+
+type funcReceiver struct{}
+
+func (funcReceiver) Method()     {}
+func (*funcReceiver) PtrMethod() {}
+
+func funcDeclaration() {}
+
+// This test verifies that functions are rendered using their name.
+func TestPrinter_FuncName(t *testing.T) {
+	closure := func() {}
+
+	test(t, "declared function", funcDeclaration, "(func())(github.com/dogmatiq/dapper_test.funcDeclaration)")
+	test(t, "closure", closure, "(func())(github.com/dogmatiq/dapper_test.TestPrinter_FuncName.func1)")
+	test(t, "method value", funcReceiver{}.Method, "(func())(github.com/dogmatiq/dapper_test.funcReceiver.Method)")
+	test(t, "method value (pointer receiver)", (&funcReceiver{}).PtrMethod, "(func())(github.com/dogmatiq/dapper_test.(*funcReceiver).PtrMethod)")
+	test(t, "method expression", funcReceiver.Method, "(func(github.com/dogmatiq/dapper_test.funcReceiver))(github.com/dogmatiq/dapper_test.funcReceiver.Method)")
+	test(t, "standard library", strings.ToUpper, "(func(string) string)(strings.ToUpper)")
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithPackagePaths(false)),
+		"without package paths",
+		closure,
+		"(func())(dapper_test.TestPrinter_FuncName.func1)",
+	)
+
+	_, file, line, _ := runtime.Caller(0)
+	located := func() {}
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithFuncSourceLocations(true)),
+		"with source locations",
+		located,
+		fmt.Sprintf("(func())(github.com/dogmatiq/dapper_test.TestPrinter_FuncName.func2 (%s:%d))", file, line+1),
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithFuncSourceLocations(true)),
+		"method values do not have a source location",
+		funcReceiver{}.Method,
+		"(func())(github.com/dogmatiq/dapper_test.funcReceiver.Method)",
+	)
+
+	counter := func(n int) func() int {
+		return func() int { return n }
+	}
+	one := counter(1)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithStablePointers(true), WithPackagePaths(false)),
+		"closures with stable pointers",
+		[]any{one, counter(2), one, funcDeclaration, (&funcReceiver{}).PtrMethod},
+		"[]any{",
+		"    (func() int)(dapper_test.TestPrinter_FuncName.func3.func1#1)",
+		"    (func() int)(dapper_test.TestPrinter_FuncName.func3.func1#2)",
+		"    (func() int)(dapper_test.TestPrinter_FuncName.func3.func1#1)",
+		"    (func())(dapper_test.funcDeclaration)",
+		"    (func())(dapper_test.(*funcReceiver).PtrMethod#3)",
+		"}",
+	)
+}
+
 // See https://github.com/dogmatiq/dapper/issues/6
 func TestPrinter_StringAndBoolTypeNames(t *testing.T) {
 	type MyString string
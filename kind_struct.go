@@ -7,6 +7,16 @@ import (
 
 // renderStructKind renders [reflect.Struct] values.
 func renderStructKind(r Renderer, v Value) {
+	renderStruct(r, v, nil)
+}
+
+// fieldRenderer is a function that returns the [Renderer] to use to render the
+// value of a specific struct field.
+type fieldRenderer func(r Renderer, f reflect.StructField) Renderer
+
+// renderStruct renders the struct v. If fr is non-nil, it is used to obtain
+// the renderer for each field.
+func renderStruct(r Renderer, v Value, fr fieldRenderer) {
 	// We don't render anonymous types even if the type is ambiguous. Otherwise
 	// we'd be printing the full type definition of the anonymous type. Instead
 	// we mark each field as ambiguous and render their types inline.
@@ -27,14 +37,14 @@ func renderStructKind(r Renderer, v Value) {
 	r.Print("{\n")
 	r.Indent()
 
-	renderStructFields(r, v)
+	renderStructFields(r, v, fr)
 
 	r.Outdent()
 	r.Print("}")
 }
 
-func renderStructFields(r Renderer, v Value) error {
-	renderUnexported := r.Config().RenderUnexportedStructFields
+func renderStructFields(r Renderer, v Value, fr fieldRenderer) error {
+	renderUnexported := configOf(r).RenderUnexportedStructFields
 	alignment := longestFieldName(v.DynamicType, renderUnexported)
 
 	for i := 0; i < v.DynamicType.NumField(); i++ {
@@ -56,7 +66,12 @@ func renderStructFields(r Renderer, v Value) error {
 			),
 		)
 
-		r.WriteValue(
+		fieldR := r
+		if fr != nil {
+			fieldR = fr(r, f)
+		}
+
+		fieldR.WriteValue(
 			Value{
 				Value:                  fv,
 				DynamicType:            fv.Type(),
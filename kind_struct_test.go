@@ -182,7 +182,7 @@ func TestPrinter_StructUnexportedFields(t *testing.T) {
 		"    vUintptr:       0xabcd",
 		"    vUnsafePointer: "+pointerTargetHex,
 		"    vChannel:       "+channelHex,
-		"    vFunc:          "+funcHex,
+		"    vFunc:          "+funcName,
 		"    vIface:         int(100)",
 		"    vStruct:        {}",
 		"    vPtr:           123",
@@ -2,13 +2,17 @@ package dapper
 
 import (
 	"io"
+	"maps"
 	"os"
 	"reflect"
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dogmatiq/dapper/internal/stream"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 const (
@@ -20,6 +24,10 @@ const (
 	// within a value.
 	recursionMarker = "<recursion>"
 
+	// truncatedMarker is the string to display in place of the content of a
+	// value that is nested more deeply than the maximum depth.
+	truncatedMarker = "..."
+
 	// annotationPrefix is the string to display before annotations.
 	annotationPrefix = "<<"
 
@@ -61,11 +69,141 @@ type Config struct {
 	// RenderUnexportedStructFields, when true, causes the printer to render
 	// unexported struct fields.
 	RenderUnexportedStructFields bool
+
+	// StablePointers, when true, causes the printer to render the addresses
+	// of channels and untyped pointers as ordinal identities assigned in the
+	// order that they are encountered, rather than their actual memory
+	// address.
+	//
+	// Functions are rendered by name regardless of this option. When it is
+	// enabled, the names of closures and method values are followed by the
+	// identity of the closure, such as "pkg.Outer.func1#2", so that closures
+	// with different captured variables can be distinguished. A function
+	// whose name can not be determined is rendered as an identity such as
+	// "func#3".
+	//
+	// This produces output that is identical across program executions, while
+	// still indicating when the same address is encountered multiple times.
+	// Map entries are sorted before identities are assigned to their keys,
+	// however entries that differ only by the addresses they contain are
+	// indistinguishable, and may be assigned different identities on each
+	// execution.
+	StablePointers bool
+
+	// deferPointerIDs, when true, causes the printer to render stable pointer
+	// identities as "<label>#?" without assigning them. It is used to render
+	// map keys before they are sorted, so that identities are assigned in the
+	// order that the keys are displayed.
+	deferPointerIDs bool
+
+	// RenderFuncSourceLocations, when true, causes the printer to render the
+	// source file and line number at which a function is declared, in
+	// addition to its name.
+	RenderFuncSourceLocations bool
+
+	// RenderChanContents, when true, causes the printer to render the values
+	// in a channel's buffer, without receiving them, along with whether the
+	// channel is closed and the number of goroutines blocked on it.
+	//
+	// This relies on the internal layout of the runtime's channel
+	// implementation. If the layout is not recognized channels are rendered as
+	// though this option were disabled.
+	RenderChanContents bool
+
+	// RenderErrorTrees, when true, causes the printer to render errors as a
+	// tree of the errors they wrap, with each layer showing its concrete type
+	// and message, instead of rendering the error's internal structure.
+	RenderErrorTrees bool
+
+	// RenderDriverValues, when true, causes the printer to render any
+	// implementation of [database/sql/driver.Valuer] as the result of its
+	// Value() method. The result is not itself rendered as a driver value,
+	// even if it also implements [database/sql/driver.Valuer].
+	RenderDriverValues bool
+
+	// RenderSensitiveData, when true, causes the printer to render data that
+	// is typically redacted, such as passwords within URLs and the values of
+	// authentication and cookie headers in HTTP requests and responses.
+	RenderSensitiveData bool
+
+	// Compact, when true, causes the printer to render values on a single line
+	// instead of across multiple indented lines.
+	Compact bool
+
+	// TimeLayout is the layout used to render [time.Time] values, as accepted
+	// by [time.Time.Format]. If it is empty, [time.RFC3339Nano] is used.
+	TimeLayout string
+
+	// RenderTimesInUTC, when true, causes the printer to convert [time.Time]
+	// values to UTC before rendering them, making the output independent of
+	// the local time zone.
+	RenderTimesInUTC bool
+
+	// RenderMonotonicClock, when true, causes the printer to annotate
+	// [time.Time] values with their monotonic clock reading, if present.
+	RenderMonotonicClock bool
+
+	// ReferenceTime, if non-zero, causes the printer to annotate [time.Time]
+	// values with their offset from this instant.
+	ReferenceTime time.Time
+
+	// ProtoTypeResolver is used to resolve the type URL of protocol buffers
+	// google.protobuf.Any messages so that their payload can be rendered. If
+	// it is nil, [protoregistry.GlobalTypes] is used.
+	ProtoTypeResolver protoregistry.MessageTypeResolver
+
+	// protoBytesFields is a map of struct types to the names of their fields
+	// that contain binary protocol buffers messages, and the descriptor of
+	// those messages.
+	protoBytesFields map[reflect.Type]map[string]protoreflect.MessageDescriptor
+
+	// MaxDepth is the maximum depth of nested structs, maps, slices and arrays
+	// to render. Values nested more deeply are rendered as "{...}". A value of
+	// zero means there is no limit.
+	//
+	// The limit also applies to the blocks rendered by filters, such as the
+	// fields of protocol buffers messages and the members of JSON objects. A
+	// filter opens a block by calling [Renderer.Indent], and only values that
+	// open a block count towards the depth.
+	MaxDepth int
+
+	// MaxElements is the maximum number of elements to render for each map,
+	// slice or array. A value of zero means there is no limit, and a negative
+	// value means that no elements are rendered.
+	//
+	// The built-in filters also apply the limit to collections that they
+	// render, such as repeated protocol buffers fields, JSON arrays, HTTP
+	// headers, log attributes and the buffered values of channels. Struct
+	// fields and error chains are not limited, and stack traces are limited
+	// by MaxStackFrames instead.
+	MaxElements int
+
+	// RenderProgramCounters, when true, causes the printer to render []uintptr
+	// values as stack traces if every element is the program counter of a
+	// known function.
+	//
+	// Stack traces returned by an error's StackTrace() method are always
+	// rendered as such, regardless of this option.
+	RenderProgramCounters bool
+
+	// MaxStackFrames is the maximum number of frames to render for each stack
+	// trace. A value of zero means there is no limit.
+	MaxStackFrames int
+
+	// IntegerFormat is the format used to render integer values that do not
+	// have an entry in IntegerFormatByType.
+	IntegerFormat IntegerFormat
+
+	// IntegerFormatByType is a map of integer types to the format used to
+	// render values of that type.
+	IntegerFormatByType map[reflect.Type]IntegerFormat
 }
 
 func (c Config) clone() Config {
 	c.Annotators = slices.Clone(c.Annotators)
 	c.Filters = slices.Clone(c.Filters)
+	c.IntegerFormatByType = maps.Clone(c.IntegerFormatByType)
+	c.protoBytesFields = maps.Clone(c.protoBytesFields)
 	return c
 }
 
@@ -118,6 +256,148 @@ func WithUnexportedStructFields(show bool) Option {
 	}
 }
 
+// WithFuncSourceLocations controls whether the printer renders the source file
+// and line number at which a function is declared. This option is disabled by
+// default.
+func WithFuncSourceLocations(show bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderFuncSourceLocations = show
+	}
+}
+
+// WithChanContents controls whether the printer renders the values in a
+// channel's buffer, and the state of goroutines blocked on the channel. This
+// option is disabled by default.
+func WithChanContents(show bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderChanContents = show
+	}
+}
+
+// WithErrorTrees controls whether the printer renders errors as a tree of the
+// errors they wrap. This option is disabled by default.
+//
+// Errors are unwrapped using their Unwrap() error or Unwrap() []error method,
+// as per the [errors] package.
+func WithErrorTrees(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderErrorTrees = enabled
+	}
+}
+
+// WithDriverValues controls whether the printer renders implementations of
+// [database/sql/driver.Valuer] as the result of their Value() method. This
+// option is disabled by default.
+func WithDriverValues(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderDriverValues = enabled
+	}
+}
+
+// WithCompact controls whether the printer renders values on a single line.
+// This option is disabled by default.
+func WithCompact(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.Compact = enabled
+	}
+}
+
+// WithTimeLayout sets the layout used to render [time.Time] values, as
+// accepted by [time.Time.Format]. The default is [time.RFC3339Nano].
+func WithTimeLayout(layout string) Option {
+	return func(cfg *Config) {
+		cfg.TimeLayout = layout
+	}
+}
+
+// WithUTCTimes controls whether the printer converts [time.Time] values to UTC
+// before rendering them. This option is disabled by default.
+func WithUTCTimes(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderTimesInUTC = enabled
+	}
+}
+
+// WithMonotonicClock controls whether the printer annotates [time.Time] values
+// with their monotonic clock reading, such as "<<m=+0.012300001>>". This
+// option is disabled by default.
+func WithMonotonicClock(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderMonotonicClock = enabled
+	}
+}
+
+// WithReferenceTime causes the printer to annotate [time.Time] values with
+// their offset from ref, such as "<<+3h2m from ref>>". A zero value disables
+// the annotation, which is the default.
+func WithReferenceTime(ref time.Time) Option {
+	return func(cfg *Config) {
+		cfg.ReferenceTime = ref
+	}
+}
+
+// WithProtoTypeResolver sets the resolver used to find the message types of
+// the payloads within protocol buffers google.protobuf.Any messages. The
+// default is [protoregistry.GlobalTypes].
+func WithProtoTypeResolver(res protoregistry.MessageTypeResolver) Option {
+	return func(cfg *Config) {
+		cfg.ProtoTypeResolver = res
+	}
+}
+
+// WithMaxDepth sets the maximum depth of nested structs, maps, slices and
+// arrays to render. A value of zero, the default, means there is no limit.
+func WithMaxDepth(n int) Option {
+	return func(cfg *Config) {
+		cfg.MaxDepth = n
+	}
+}
+
+// WithMaxElements sets the maximum number of elements to render for each map,
+// slice or array. A value of zero, the default, means there is no limit, and a
+// negative value means that no elements are rendered.
+func WithMaxElements(n int) Option {
+	return func(cfg *Config) {
+		cfg.MaxElements = n
+	}
+}
+
+// WithProgramCounters controls whether the printer renders []uintptr values
+// that contain program counters as stack traces. This option is disabled by
+// default.
+func WithProgramCounters(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderProgramCounters = enabled
+	}
+}
+
+// WithMaxStackFrames sets the maximum number of frames to render for each stack
+// trace. A value of zero, the default, means there is no limit.
+func WithMaxStackFrames(n int) Option {
+	return func(cfg *Config) {
+		cfg.MaxStackFrames = n
+	}
+}
+
+// WithSensitiveData controls whether the printer renders data that is typically
+// redacted, such as passwords within URLs and authentication headers. This
+// option is disabled by default.
+func WithSensitiveData(show bool) Option {
+	return func(cfg *Config) {
+		cfg.RenderSensitiveData = show
+	}
+}
+
+// WithStablePointers controls whether the printer renders memory addresses as
+// ordinal identities, such as "chan#1", instead of their actual value. The
+// names of closures and method values are followed by an identity, such as
+// "pkg.Outer.func1#2". This option is disabled by default.
+func WithStablePointers(enabled bool) Option {
+	return func(cfg *Config) {
+		cfg.StablePointers = enabled
+	}
+}
+
 // NewPrinter returns a new [Printer] with the given options applied.
 func NewPrinter(options ...Option) *Printer {
 	cfg := Config{
@@ -162,12 +442,20 @@ func (p *Printer) Write(w io.Writer, v any) (_ int, err error) {
 		Target: w,
 	}
 
+	var target io.Writer = counter
+	if p.cfg.Compact {
+		target = &stream.Compactor{
+			Target: counter,
+		}
+	}
+
 	r := &renderer{
 		cfg: p.cfg,
 		Indenter: stream.Indenter{
-			Target: counter,
+			Target: target,
 		},
 		RecursionSet: map[uintptr]struct{}{},
+		PointerIDs:   map[uintptr]int{},
 	}
 
 	rv := reflect.ValueOf(v)
@@ -188,6 +476,8 @@ func (p *Printer) Write(w io.Writer, v any) (_ int, err error) {
 		},
 	)
 
+	r.mustFlush()
+
 	return counter.Count(), nil
 }
 
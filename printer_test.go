@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"testing"
 
 	. "github.com/dogmatiq/dapper"
 )
@@ -123,3 +124,29 @@ func ExampleWrite() {
 
 	// output: int(123)
 }
+
+func TestPrinter_WithCompact(t *testing.T) {
+	type compactNode struct {
+		Name     string
+		Labels   map[string]string
+		Children []*compactNode
+	}
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithCompact(true)),
+		"nested values",
+		&compactNode{
+			Name: "root: a  b",
+			Labels: map[string]string{
+				"a":    "1",
+				"long": "2",
+			},
+			Children: []*compactNode{
+				{Name: "leaf"},
+				{},
+			},
+		},
+		`*github.com/dogmatiq/dapper_test.compactNode{Name: "root: a  b", Labels: {"a": "1", "long": "2"}, Children: {{Name: "leaf", Labels: nil, Children: nil}, {<zero>}}}`,
+	)
+}
@@ -1,6 +1,7 @@
 package dapper
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"reflect"
@@ -23,10 +24,19 @@ type Renderer interface {
 	WriteValue(Value)
 	FormatValue(Value) string
 
+	// Indent increases the indentation and depth of subsequent output, which
+	// opens a block. If the block is nested more deeply than
+	// [Config.MaxDepth] its content is discarded until the matching call to
+	// Outdent(), and it is rendered as "{...}" if the preceding output ends
+	// with "{" and a line break.
 	Indent()
 	Outdent()
 	Print(format string, args ...any)
 
+	// PointerID returns the ordinal identity of the address p, as rendered
+	// when [Config.StablePointers] is enabled.
+	PointerID(p uintptr) int
+
 	WithModifiedConfig(func(*Config)) Renderer
 }
 
@@ -36,16 +46,71 @@ type renderer struct {
 	Indenter       stream.Indenter
 	ProducedOutput bool
 	RecursionSet   map[uintptr]struct{}
+	PointerIDs     map[uintptr]int
+	Depth          int
 	FilterIndex    int
 	FilterValue    *Value
+
+	// TruncatedDepth is the depth of the block that is being truncated
+	// because it is nested more deeply than [Config.MaxDepth], or zero if no
+	// block is being truncated. Any output within the block is discarded.
+	TruncatedDepth int
+
+	// PendingLineBreak is true if the line break after the opening of a block
+	// has been withheld, so that it can be omitted if the block is truncated.
+	PendingLineBreak bool
 }
 
+var (
+	lineBreak = []byte("\n")
+	openBlock = []byte("{\n")
+)
+
 func (r *renderer) Write(data []byte) (int, error) {
-	n, err := r.Indenter.Write(data)
-	if n > 0 {
+	size := len(data)
+	if size > 0 {
 		r.ProducedOutput = true
 	}
-	return n, err
+
+	if r.TruncatedDepth != 0 {
+		return size, nil
+	}
+
+	if err := r.flush(); err != nil {
+		return 0, err
+	}
+
+	// A block opened at the maximum depth is truncated as soon as it is
+	// indented, so the line break is withheld until it's known whether the
+	// block is rendered.
+	if r.isAtMaxDepth() && bytes.HasSuffix(data, openBlock) {
+		data = data[:len(data)-1]
+		r.PendingLineBreak = true
+	}
+
+	if _, err := r.Indenter.Write(data); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// flush writes any pending line break.
+func (r *renderer) flush() error {
+	if !r.PendingLineBreak {
+		return nil
+	}
+
+	r.PendingLineBreak = false
+	_, err := r.Indenter.Write(lineBreak)
+	return err
+}
+
+// mustFlush writes any pending line break, or panics if it can not be written.
+func (r *renderer) mustFlush() {
+	if err := r.flush(); err != nil {
+		panic(panicSentinel{err})
+	}
 }
 
 func (r *renderer) Config() Config {
@@ -60,7 +125,9 @@ func (r *renderer) Print(format string, args ...any) {
 
 func (r *renderer) FormatType(v Value) string {
 	var w strings.Builder
-	r.child(&w, r.cfg).WriteType(v)
+	c := r.child(&w, r.cfg)
+	c.WriteType(v)
+	c.mustFlush()
 	return w.String()
 }
 
@@ -113,7 +180,9 @@ func renderType(r Renderer, c Config, t reflect.Type) {
 
 func (r *renderer) FormatValue(v Value) string {
 	var w strings.Builder
-	r.child(&w, r.cfg).WriteValue(v)
+	c := r.child(&w, r.cfg)
+	c.WriteValue(v)
+	c.mustFlush()
 	return w.String()
 }
 
@@ -123,7 +192,12 @@ func (r *renderer) WriteValue(v Value) {
 	if !isFilterValue {
 		var annotations []string
 		for _, annotate := range r.cfg.Annotators {
-			if a := annotate(v); a != "" {
+			var a string
+			if p, ok := recoverUserPanic(func() { a = annotate(v) }); ok {
+				a = fmt.Sprintf("panic: %v", p)
+			}
+
+			if a != "" {
 				annotations = append(annotations, a)
 			}
 		}
@@ -161,22 +235,66 @@ func (r *renderer) WriteValue(v Value) {
 
 	v.Value = unsafereflect.MakeMutable(v.Value)
 
+	// panics is the set of values recovered from panicking filters.
+	var panics []any
+
+	defer func() {
+		for _, p := range panics {
+			r.Print(" <panic: %v>", p)
+		}
+	}()
+
+	// The output of each filter is buffered so that it can be discarded if the
+	// filter panics part way through rendering. The same renderer and buffer
+	// are reused for each filter, and the buffer only allocates memory once a
+	// filter produces some output.
+	var (
+		out   filterOutput
+		child *renderer
+	)
+
 	for index, filter := range r.cfg.Filters {
 		if r.FilterIndex == index && isFilterValue {
 			continue
 		}
 
-		child := r.child(r, r.cfg)
+		if child == nil {
+			child = r.child(&out, r.cfg)
+			child.FilterValue = &v
+		} else {
+			out = out[:0]
+			child.Indenter = stream.Indenter{Target: &out}
+			child.ProducedOutput = false
+			child.Depth = r.Depth
+			child.TruncatedDepth = 0
+			child.PendingLineBreak = false
+		}
+
 		child.FilterIndex = index
-		child.FilterValue = &v
 
-		filter(child, v)
+		if p, ok := recoverUserPanic(func() { filter(child, v) }); ok {
+			panics = append(panics, p)
+			continue
+		}
 
 		if child.ProducedOutput {
+			child.mustFlush()
+
+			if _, err := r.Write(out); err != nil {
+				panic(panicSentinel{err})
+			}
 			return
 		}
 	}
 
+	if r.isBeyondMaxDepth(v) {
+		if v.IsAmbiguousType() && !v.IsAnonymousType() {
+			r.WriteType(v)
+		}
+		r.Print("{%s}", truncatedMarker)
+		return
+	}
+
 	switch v.DynamicType.Kind() {
 	case reflect.String:
 		renderStringKind(r, v)
@@ -217,10 +335,31 @@ func (r *renderer) WriteValue(v Value) {
 
 func (r *renderer) Indent() {
 	r.Indenter.Depth++
+	r.Depth++
+
+	if r.TruncatedDepth != 0 || r.cfg.MaxDepth <= 0 || r.Depth <= r.cfg.MaxDepth {
+		return
+	}
+
+	// Render the truncated block as "{...}" if it was opened on the preceding
+	// line, otherwise place the marker within the block.
+	marker := truncatedMarker + "\n"
+	if r.PendingLineBreak {
+		r.PendingLineBreak = false
+		marker = truncatedMarker
+	}
+
+	r.Print("%s", marker)
+	r.TruncatedDepth = r.Depth
 }
 
 func (r *renderer) Outdent() {
+	if r.TruncatedDepth == r.Depth {
+		r.TruncatedDepth = 0
+	}
+
 	r.Indenter.Depth--
+	r.Depth--
 }
 
 func (r *renderer) WithModifiedConfig(modify func(*Config)) Renderer {
@@ -236,15 +375,65 @@ func (r *renderer) child(w io.Writer, c Config) *renderer {
 		},
 		cfg:          c,
 		RecursionSet: r.RecursionSet,
+		PointerIDs:   r.PointerIDs,
+		Depth:        r.Depth,
 		FilterIndex:  r.FilterIndex,
 		FilterValue:  r.FilterValue,
 	}
 }
 
+// filterOutput is an [io.Writer] that buffers the output of a [Filter].
+type filterOutput []byte
+
+func (w *filterOutput) Write(data []byte) (int, error) {
+	*w = append(*w, data...)
+	return len(data), nil
+}
+
+// configOf returns the configuration of r.
+//
+// Unlike [Renderer.Config], it does not return a copy of the configuration if
+// r is the package's own [Renderer] implementation, which avoids allocating
+// memory on paths that are executed for every value. The returned
+// configuration must not be modified.
+func configOf(r Renderer) *Config {
+	if r, ok := r.(*renderer); ok {
+		return &r.cfg
+	}
+
+	c := r.Config()
+	return &c
+}
+
+// isBeyondMaxDepth returns true if v is a struct, map, slice or array with
+// content that would be nested more deeply than [Config.MaxDepth].
+func (r *renderer) isBeyondMaxDepth(v Value) bool {
+	if !r.isAtMaxDepth() {
+		return false
+	}
+
+	switch v.DynamicType.Kind() {
+	case reflect.Struct:
+		return !v.Value.IsZero()
+	case reflect.Map, reflect.Slice:
+		return !v.Value.IsNil() && v.Value.Len() != 0
+	case reflect.Array:
+		return v.Value.Len() != 0
+	default:
+		return false
+	}
+}
+
+// isAtMaxDepth returns true if any block opened by r would be nested more
+// deeply than [Config.MaxDepth].
+func (r *renderer) isAtMaxDepth() bool {
+	return r.cfg.MaxDepth > 0 && r.Depth >= r.cfg.MaxDepth
+}
+
 // enter indicates that a potentially value is about to be formatted.
 // It returns true if recursion has occurred, indicating that the value should.
 func (r *renderer) enter(v Value) bool {
-	if possiblyRecursive(v) {
+	if r.possiblyRecursive(v) {
 		ptr := v.Value.Pointer()
 		if _, ok := r.RecursionSet[ptr]; ok {
 			return true
@@ -259,23 +448,64 @@ func (r *renderer) enter(v Value) bool {
 //
 // It must be called after enter(v) returns true.
 func (r *renderer) leave(v Value) {
-	if possiblyRecursive(v) {
+	if r.possiblyRecursive(v) {
 		delete(r.RecursionSet, v.Value.Pointer())
 	}
 }
 
+// PointerID returns the ordinal identity of the address p.
+//
+// Identities are assigned in the order that addresses are first encountered,
+// such that the same address always produces the same identity.
+func (r *renderer) PointerID(p uintptr) int {
+	id, ok := r.PointerIDs[p]
+	if !ok {
+		id = len(r.PointerIDs) + 1
+		r.PointerIDs[p] = id
+	}
+	return id
+}
+
 // possiblyRecursive returns true if v may be a recursive data structure.
-func possiblyRecursive(v Value) bool {
+//
+// Channels are only recursive if their buffered values are rendered, as a
+// channel's buffer may contain the channel itself.
+func (r *renderer) possiblyRecursive(v Value) bool {
 	switch v.DynamicType.Kind() {
 	case reflect.Map,
 		reflect.Ptr,
 		reflect.Slice:
 		return true
+	case reflect.Chan:
+		return r.cfg.RenderChanContents
 	default:
 		return false
 	}
 }
 
+// recoverUserPanic calls fn, which may invoke user-defined code such as a
+// [Filter] or a method on the value being rendered. If fn panics, it returns
+// the recovered value and true.
+//
+// Panics that carry a [panicSentinel] are not recovered, as they must be
+// propagated to [Printer.Write].
+func recoverUserPanic(fn func()) (recovered any, panicked bool) {
+	defer func() {
+		if p := recover(); p != nil {
+			if _, ok := p.(panicSentinel); ok {
+				panic(p)
+			}
+
+			recovered = p
+			panicked = true
+		}
+	}()
+
+	fn()
+
+	return nil, false
+}
+
 // printWithTypeIfAmbiguous prints a format string and arguments. If v's type is
 // ambiguous the formatted string is prefixed with the type name.
 func printWithTypeIfAmbiguous(
@@ -291,3 +521,17 @@ func printWithTypeIfAmbiguous(
 		r.Print(format, args...)
 	}
 }
+
+// writeWithTypeIfAmbiguous renders inner, which is the value represented by v.
+// If v's type is ambiguous the rendered value is wrapped in parentheses and
+// prefixed with the type name.
+func writeWithTypeIfAmbiguous(r Renderer, v, inner Value) {
+	if v.IsAmbiguousType() {
+		r.WriteType(v)
+		r.Print("(")
+		r.WriteValue(inner)
+		r.Print(")")
+	} else {
+		r.WriteValue(inner)
+	}
+}
@@ -0,0 +1,112 @@
+package dapper
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogValue returns an [slog.LogValuer] that renders v using [DefaultPrinter]
+// when it is logged.
+//
+// v is not rendered unless the log record is handled.
+func LogValue(v any) slog.LogValuer {
+	return logValuer{defaultPrinter, v}
+}
+
+// logValuer is an implementation of [slog.LogValuer] that renders a value
+// using a [Printer].
+type logValuer struct {
+	printer *Printer
+	value   any
+}
+
+func (v logValuer) LogValue() slog.Value {
+	return slog.StringValue(v.printer.Format(v.value))
+}
+
+// LogHandler is an [slog.Handler] that renders non-primitive attribute values
+// using a [Printer] before passing records to another handler.
+type LogHandler struct {
+	next    slog.Handler
+	printer *Printer
+}
+
+var _ slog.Handler = (*LogHandler)(nil)
+
+// NewLogHandler returns an [slog.Handler] that renders non-primitive attribute
+// values using p before passing records to next.
+//
+// If p is nil, [DefaultPrinter] is used. If next is an [slog.TextHandler],
+// values are rendered on a single line, as per [WithCompact].
+func NewLogHandler(next slog.Handler, p *Printer) *LogHandler {
+	if p == nil {
+		p = defaultPrinter
+	}
+
+	if _, ok := next.(*slog.TextHandler); ok && !p.cfg.Compact {
+		cfg := p.cfg.clone()
+		cfg.Compact = true
+		p = &Printer{cfg}
+	}
+
+	return &LogHandler{next, p}
+}
+
+// Enabled reports whether the handler handles records at the given level.
+func (h *LogHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	return h.next.Enabled(ctx, l)
+}
+
+// Handle renders the non-primitive attribute values of rec and passes the
+// result to the next handler.
+func (h *LogHandler) Handle(ctx context.Context, rec slog.Record) error {
+	out := slog.NewRecord(rec.Time, rec.Level, rec.Message, rec.PC)
+
+	rec.Attrs(
+		func(a slog.Attr) bool {
+			out.AddAttrs(h.render(a))
+			return true
+		},
+	)
+
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs returns a new handler with the given attributes, rendering their
+// non-primitive values.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	rendered := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		rendered[i] = h.render(a)
+	}
+
+	return &LogHandler{h.next.WithAttrs(rendered), h.printer}
+}
+
+// WithGroup returns a new handler that qualifies subsequent attributes with
+// the given group name.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{h.next.WithGroup(name), h.printer}
+}
+
+// render returns a copy of a with its value rendered by the handler's printer,
+// if it is not a primitive value.
+func (h *LogHandler) render(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+
+	switch a.Value.Kind() {
+	case slog.KindAny:
+		a.Value = slog.StringValue(h.printer.Format(a.Value.Any()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		rendered := make([]slog.Attr, len(group))
+
+		for i, x := range group {
+			rendered[i] = h.render(x)
+		}
+
+		a.Value = slog.GroupValue(rendered...)
+	}
+
+	return a
+}
@@ -0,0 +1,89 @@
+package dapper_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: dropLogTime,
+	}))
+
+	logger.Info("hello", "point", LogValue(slogPoint{1, 2}))
+
+	expected := `{"level":"INFO","msg":"hello","point":"github.com/dogmatiq/dapper_test.slogPoint{\n    X: 1\n    Y: 2\n}"}` + "\n"
+	if buf.String() != expected {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	t.Run("it renders non-primitive values on a single line for text handlers", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(
+			NewLogHandler(
+				slog.NewTextHandler(&buf, &slog.HandlerOptions{
+					ReplaceAttr: dropLogTime,
+				}),
+				nil,
+			),
+		)
+
+		logger.
+			With("point", slogPoint{1, 2}).
+			WithGroup("g").
+			Info(
+				"hello",
+				"count", 3,
+				slog.Group("sub", "point", &slogPoint{3, 4}),
+			)
+
+		expected := `level=INFO msg=hello ` +
+			`point="github.com/dogmatiq/dapper_test.slogPoint{X: 1, Y: 2}" ` +
+			`g.count=3 ` +
+			`g.sub.point="*github.com/dogmatiq/dapper_test.slogPoint{X: 3, Y: 4}"` + "\n"
+
+		if buf.String() != expected {
+			t.Fatalf("unexpected output:\n%s", buf.String())
+		}
+	})
+
+	t.Run("it uses the given printer", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(
+			NewLogHandler(
+				slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+					ReplaceAttr: dropLogTime,
+				}),
+				NewPrinter(WithPackagePaths(false)),
+			),
+		)
+
+		logger.Info("hello", "point", slogPoint{1, 2})
+
+		if !strings.Contains(buf.String(), `"point":"dapper_test.slogPoint{\n    X: 1\n    Y: 2\n}"`) {
+			t.Fatalf("unexpected output:\n%s", buf.String())
+		}
+	})
+
+	t.Run("it does not handle records at disabled levels", func(t *testing.T) {
+		h := NewLogHandler(slog.NewTextHandler(&bytes.Buffer{}, nil), nil)
+
+		if h.Enabled(t.Context(), slog.LevelDebug) {
+			t.Fatal("expected debug level to be disabled")
+		}
+	})
+}
+
+func dropLogTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+	return a
+}
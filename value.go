@@ -3,9 +3,6 @@ package dapper
 import (
 	"fmt"
 	"reflect"
-	"sync/atomic"
-
-	"github.com/dogmatiq/dapper/internal/unsafereflect"
 )
 
 // Value contains information about a Go value that is to be formatted.
@@ -110,9 +107,27 @@ func zero[T any]() (_ T) {
 	return
 }
 
+// asBool returns the value of v as a bool, if it is a boolean type, including
+// [atomic.Bool].
+func asBool(v reflect.Value) (b bool, ok bool) {
+	if a, ok := loadAtomic(v); ok {
+		v = a
+	}
+
+	if v.Kind() == reflect.Bool {
+		return v.Bool(), true
+	}
+
+	return false, false
+}
+
 // asInt returns the value of v as an int64, if it is one of the signed integer
 // types, including atomic types.
 func asInt(v reflect.Value) (n int64, ok bool) {
+	if a, ok := loadAtomic(v); ok {
+		v = a
+	}
+
 	switch v.Kind() {
 	case reflect.Int,
 		reflect.Int8,
@@ -120,15 +135,6 @@ func asInt(v reflect.Value) (n int64, ok bool) {
 		reflect.Int32,
 		reflect.Int64:
 		return v.Int(), true
-	}
-
-	v = unsafereflect.MakeMutable(v)
-
-	switch v := v.Interface().(type) {
-	case atomic.Int32:
-		return int64(v.Load()), true
-	case atomic.Int64:
-		return v.Load(), true
 	default:
 		return 0, false
 	}
@@ -137,6 +143,10 @@ func asInt(v reflect.Value) (n int64, ok bool) {
 // asUint returns the value of v as a uint64, if it is one of the unsigned
 // integer types, including atomic types.
 func asUint(v reflect.Value) (n uint64, ok bool) {
+	if a, ok := loadAtomic(v); ok {
+		v = a
+	}
+
 	switch v.Kind() {
 	case reflect.Uint,
 		reflect.Uint8,
@@ -144,15 +154,6 @@ func asUint(v reflect.Value) (n uint64, ok bool) {
 		reflect.Uint32,
 		reflect.Uint64:
 		return v.Uint(), true
-	}
-
-	v = unsafereflect.MakeMutable(v)
-
-	switch v := v.Interface().(type) {
-	case atomic.Uint32:
-		return uint64(v.Load()), true
-	case atomic.Uint64:
-		return v.Load(), true
 	default:
 		return 0, false
 	}
@@ -0,0 +1,68 @@
+package dapper
+
+import (
+	"fmt"
+)
+
+// Wrap returns a [fmt.Formatter] that renders v using [DefaultPrinter].
+//
+// See [Printer.Wrap] for details of the supported verbs and flags.
+func Wrap(v any) fmt.Formatter {
+	return defaultPrinter.Wrap(v)
+}
+
+// Wrap returns a [fmt.Formatter] that renders v using p.
+//
+// The %v and %s verbs render v on a single line, as per [WithCompact]. The
+// %+v verb renders v across multiple lines, and %#v renders the Go-syntax
+// representation of v, as per the [fmt] package.
+//
+// The width and precision are used as the maximum depth and maximum number of
+// elements, as per [WithMaxDepth] and [WithMaxElements], respectively. For
+// example, %+2.10v renders values nested up to two levels deep, and at most
+// ten elements of each map, slice or array. A precision of zero renders none
+// of the elements, such that %.0v shows only the size of each collection.
+func (p *Printer) Wrap(v any) fmt.Formatter {
+	return wrapped{p, v}
+}
+
+// wrapped is a value that is rendered by a [Printer] when formatted using the
+// [fmt] package.
+type wrapped struct {
+	printer *Printer
+	value   any
+}
+
+func (w wrapped) Format(s fmt.State, verb rune) {
+	cfg := w.printer.cfg.clone()
+	cfg.Compact = !s.Flag('+')
+
+	if n, ok := s.Width(); ok {
+		cfg.MaxDepth = n
+	}
+
+	if n, ok := s.Precision(); ok {
+		if n == 0 {
+			// A precision of zero renders no elements, rather than an
+			// unlimited number of elements.
+			n = -1
+		}
+		cfg.MaxElements = n
+	}
+
+	p := &Printer{cfg}
+
+	switch verb {
+	case 'v':
+		if s.Flag('#') {
+			fmt.Fprintf(s, "%#v", w.value)
+			return
+		}
+		fallthrough
+	case 's':
+		// There is no way to report an error from Format().
+		p.Write(s, w.value)
+	default:
+		fmt.Fprintf(s, "%%!%c(dapper.Wrap=%s)", verb, p.Format(w.value))
+	}
+}
@@ -0,0 +1,148 @@
+package dapper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/dogmatiq/dapper"
+)
+
+type wrapNode struct {
+	Name     string
+	Children []wrapNode
+}
+
+func TestWrap(t *testing.T) {
+	v := wrapNode{
+		Name: "root",
+		Children: []wrapNode{
+			{Name: "a", Children: []wrapNode{{Name: "a1"}}},
+			{Name: "b"},
+			{Name: "c"},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Format   string
+		Value    any
+		Expected string
+	}{
+		{
+			"%v renders on a single line",
+			"%v",
+			v,
+			`github.com/dogmatiq/dapper_test.wrapNode{Name: "root", Children: {{Name: "a", Children: {{Name: "a1", Children: nil}}}, {Name: "b", Children: nil}, {Name: "c", Children: nil}}}`,
+		},
+		{
+			"%s renders on a single line",
+			"%s",
+			[]int{1, 2},
+			`[]int{1, 2}`,
+		},
+		{
+			"%+v renders across multiple lines",
+			"%+v",
+			[]int{1, 2},
+			strings.Join([]string{
+				`[]int{`,
+				`    1`,
+				`    2`,
+				`}`,
+			}, "\n"),
+		},
+		{
+			"%#v renders Go syntax",
+			"%#v",
+			wrapNode{Name: "x"},
+			`dapper_test.wrapNode{Name:"x", Children:[]dapper_test.wrapNode(nil)}`,
+		},
+		{
+			"width limits the depth",
+			"%2v",
+			v,
+			`github.com/dogmatiq/dapper_test.wrapNode{Name: "root", Children: {{...}, {...}, {...}}}`,
+		},
+		{
+			"precision limits the number of elements",
+			"%.1v",
+			v,
+			`github.com/dogmatiq/dapper_test.wrapNode{Name: "root", Children: {{Name: "a", Children: {{Name: "a1", Children: nil}}}, <2 more element(s)>}}`,
+		},
+		{
+			"width and precision with multiple lines",
+			"%+3.1v",
+			v,
+			strings.Join([]string{
+				`github.com/dogmatiq/dapper_test.wrapNode{`,
+				`    Name:     "root"`,
+				`    Children: {`,
+				`        {`,
+				`            Name:     "a"`,
+				`            Children: {...}`,
+				`        }`,
+				`        <2 more element(s)>`,
+				`    }`,
+				`}`,
+			}, "\n"),
+		},
+		{
+			"zero precision renders no elements",
+			"%.0v",
+			v,
+			`github.com/dogmatiq/dapper_test.wrapNode{Name: "root", Children: {<3 more element(s)>}}`,
+		},
+		{
+			"unsupported verb",
+			"%d",
+			"foo",
+			`%!d(dapper.Wrap="foo")`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			actual := fmt.Sprintf(c.Format, Wrap(c.Value))
+			if actual != c.Expected {
+				t.Fatalf("unexpected output:\nexpected: %s\nactual:   %s", c.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestPrinter_Wrap(t *testing.T) {
+	p := NewPrinter(WithPackagePaths(false))
+
+	actual := fmt.Sprintf("%v", p.Wrap(wrapNode{Name: "x"}))
+	expected := `dapper_test.wrapNode{Name: "x", Children: nil}`
+
+	if actual != expected {
+		t.Fatalf("unexpected output:\nexpected: %s\nactual:   %s", expected, actual)
+	}
+}
+
+func TestPrinter_WithMaxElements(t *testing.T) {
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxElements(2)),
+		"map",
+		map[string]int{"a": 1, "b": 2, "cccccc": 3},
+		`map[string]int{`,
+		`    "a": 1`,
+		`    "b": 2`,
+		`    <1 more element(s)>`,
+		`}`,
+	)
+
+	testWithPrinter(
+		t,
+		NewPrinter(WithMaxElements(2)),
+		"byte slice",
+		[]byte("hello"),
+		`[]uint8{`,
+		`    00000000  68 65                                             |he|`,
+		`    <3 more element(s)>`,
+		`}`,
+	)
+}